@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ChangeModeGit = "git" // Clone, commit and push with go-git
+	ChangeModeAPI = "api" // createRef and createCommitOnBranch through GraphQL
+)
+
+// Creates the change branch and commit purely through the GraphQL API. The
+// target file is read through the repository object query, a branch is created
+// from the default branch with createRef and the change is committed with
+// createCommitOnBranch, which GitHub signs and marks as verified.
+//
+// Returns the rendered change, the base branch name and the repository ID.
+func (ghrc *GitHubRepoContext) GenerateChangeAPIBranch(
+	ctx context.Context,
+	data *ChangeTemplateData,
+	persona *Persona,
+	logger *zap.Logger) (*RenderedChange, string, string, error) {
+
+	defaultBranch, err := getDefaultBranch(ctx, ghrc.client, ghrc.org, ghrc.name)
+	if err != nil {
+		logger.Sugar().Errorf("Error getting default branch: %s", err)
+		return nil, "", "", err
+	}
+	if defaultBranch.Repository.DefaultBranchRef.Target == nil {
+		return nil, "", "", errors.New("Repository has no default branch")
+	}
+	baseRefName := defaultBranch.Repository.DefaultBranchRef.Name
+	headOid := defaultBranch.Repository.DefaultBranchRef.Target.GetOid()
+	repoId := defaultBranch.Repository.Id
+
+	file, err := getRepositoryFile(ctx, ghrc.client, ghrc.org, ghrc.name, headOid+":"+hclPath)
+	if err != nil {
+		logger.Sugar().Errorf("Error reading %s: %s", hclPath, err)
+		return nil, "", "", err
+	}
+	blob, ok := file.Repository.Object.(*getRepositoryFileRepositoryObjectBlob)
+	if !ok || blob.IsBinary {
+		return nil, "", "", fmt.Errorf("%s not found on %s", hclPath, baseRefName)
+	}
+
+	updatedContent := ApplyVersionChange([]byte(blob.Text), data)
+
+	change, err := ghrc.templates.Render(data)
+	if err != nil {
+		logger.Sugar().Errorf("Error rendering change templates: %s", err)
+		return nil, "", "", err
+	}
+
+	client := ghrc.clientFor(persona)
+	_, err = createRef(ctx, client, repoId, "refs/heads/"+change.BranchName, headOid)
+	if err != nil {
+		logger.Sugar().Errorf("Error creating branch %s: %s", change.BranchName, err)
+		return nil, "", "", err
+	}
+
+	headline, body := splitCommitMessage(change.CommitMessage)
+	body = creditPersona(body, persona)
+
+	commit, err := createCommitOnBranch(ctx,
+		client,
+		ghrc.org+"/"+ghrc.name,
+		change.BranchName,
+		headOid,
+		headline,
+		body,
+		hclPath,
+		base64.StdEncoding.EncodeToString(updatedContent))
+	if err != nil {
+		logger.Sugar().Errorf("Error committing to %s: %s", change.BranchName, err)
+		return nil, "", "", err
+	}
+	logger.Sugar().Infof("Committed %s to %s", commit.CreateCommitOnBranch.Commit.Oid, change.BranchName)
+	change.HeadSHA = commit.CreateCommitOnBranch.Commit.Oid
+
+	return change, baseRefName, repoId, nil
+}
+
+// Splits a commit message into its headline and body
+func splitCommitMessage(message string) (string, string) {
+	headline, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return strings.TrimSpace(headline), strings.TrimSpace(body)
+}
+
+// Commits made through the API are authored by the owner of the token. When
+// the persona has no token of their own, credit them with a co-author trailer.
+func creditPersona(body string, persona *Persona) string {
+	if persona.Token != "" {
+		return body
+	}
+	return strings.TrimSpace(body + "\n\nCo-authored-by: " + persona.Name + " <" + persona.Email + ">")
+}
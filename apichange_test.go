@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestGenerateChangeAPIBranch(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getDefaultBranch":     `{"repository":{"id":"R_1","defaultBranchRef":{"name":"main","target":{"__typename":"Commit","oid":"abc123"}}}}`,
+		"getRepositoryFile":    `{"repository":{"object":{"__typename":"Blob","text":"source = \"github.com/liatrio/dora-lambda-tf-module-demo?ref=v0.6.2\"","isBinary":false}}}`,
+		"createRef":            `{"createRef":{"ref":{"id":"REF_1","name":"refs/heads/dora-the-explorer-1"}}}`,
+		"createCommitOnBranch": `{"createCommitOnBranch":{"commit":{"oid":"def456","url":"https://example.com"}}}`,
+	})
+
+	var err error
+	ghrc.templates, err = NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := &ChangeTemplateData{CommitType: "fix", Epoch: 1}
+	change, baseRefName, repoId, err := ghrc.GenerateChangeAPIBranch(context.Background(), data, &defaultPersona, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Error generating change: %s", err)
+	}
+
+	if baseRefName != "main" || repoId != "R_1" {
+		t.Errorf("Expected main and R_1, got %s and %s", baseRefName, repoId)
+	}
+	if change.BranchName != "dora-the-explorer-1" {
+		t.Errorf("Unexpected branch name: %s", change.BranchName)
+	}
+	if data.ChangeType != "downgrade" || data.FromVersion != "v0.6.2" || data.ToVersion != "v0.3.0" {
+		t.Errorf("Unexpected version change: %s %s -> %s", data.ChangeType, data.FromVersion, data.ToVersion)
+	}
+
+	if expression := fake.calls["getRepositoryFile"]["expression"]; expression != "abc123:"+hclPath {
+		t.Errorf("Unexpected file expression: %v", expression)
+	}
+	commit := fake.calls["createCommitOnBranch"]
+	if commit["expectedHeadOid"] != "abc123" {
+		t.Errorf("Expected commit on top of abc123, got %v", commit["expectedHeadOid"])
+	}
+	contents, err := base64.StdEncoding.DecodeString(commit["contents"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CurrentVersion(contents) != "v0.3.0" {
+		t.Errorf("Expected committed file to reference v0.3.0, got %s", contents)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	CheckFailurePolicyClose = "close" // Close the PR and delete its branch
+	CheckFailurePolicyLeave = "leave" // Leave the PR open for a human
+	CheckFailurePolicyRetry = "retry" // Push a fix commit and wait for the checks again
+)
+
+// Returned when a pull request's status checks fail or error
+var ErrStatusChecksFailed = errors.New("status checks failed")
+
+// Added to the description of pull requests left open for a human, so the
+// sweeper leaves them alone as well
+const leftOpenMarker = "<!-- dora-the-explorer: left open -->"
+
+// Pull requests with this label are never swept either, for humans to keep a
+// pull request open by hand
+const leftOpenLabel = "dora-the-explorer: left open"
+
+// Applies the check failure policy to a pull request whose status checks
+// failed. Returns nil if a retry got the checks to pass, otherwise an error
+// wrapping ErrStatusChecksFailed once the pull request has been closed or left
+// open. The outcome is recorded in the history.
+func (ghrc *GitHubRepoContext) HandleFailedChecks(
+	ctx context.Context,
+	logger *zap.Logger,
+	prNumber int,
+	author *Persona,
+	data *ChangeTemplateData) error {
+
+	ghrc.history.Record(Event{Type: EventChecksFailed, PRNumber: prNumber})
+
+	policy := ghrc.checkFailurePolicy
+	if policy == CheckFailurePolicyRetry {
+		for attempt := 1; attempt <= ghrc.checkFailureRetries; attempt++ {
+			note := fmt.Sprintf("# dora-the-explorer: fixing checks for %s, attempt %d", data.WorkItem, attempt)
+			sha, err := ghrc.PushFixupCommit(ctx, logger, prNumber, author, "Fix failing checks", func(bb []byte) []byte {
+				return setReviewNote(bb, note)
+			})
+			if err != nil {
+				return err
+			}
+			ghrc.history.Record(Event{Type: EventChecksRetried, PRNumber: prNumber, SHA: sha, Detail: fmt.Sprintf("attempt %d", attempt)})
+			logger.Sugar().Infof("Pushed fix commit %s to PR %d, attempt %d of %d", sha, prNumber, attempt, ghrc.checkFailureRetries)
+
+			err = ghrc.WaitForStatusChecks(ctx, prNumber)
+			if err == nil {
+				ghrc.history.Record(Event{Type: EventChecksRecovered, PRNumber: prNumber, SHA: sha})
+				return nil
+			}
+			if !errors.Is(err, ErrStatusChecksFailed) {
+				return err
+			}
+		}
+		logger.Sugar().Infof("PR %d still fails its checks after %d attempts", prNumber, ghrc.checkFailureRetries)
+		policy = CheckFailurePolicyClose
+	}
+
+	switch policy {
+	case CheckFailurePolicyLeave:
+		if err := ghrc.markLeftOpen(ctx, prNumber); err != nil {
+			return err
+		}
+		ghrc.history.Record(Event{Type: EventPRLeftOpen, PRNumber: prNumber})
+		logger.Sugar().Infof("Leaving PR %d open", prNumber)
+	default:
+		if err := ghrc.ClosePullRequest(ctx, logger, prNumber); err != nil {
+			return err
+		}
+		ghrc.history.Record(Event{Type: EventPRClosed, PRNumber: prNumber, Detail: "status checks failed"})
+	}
+
+	return fmt.Errorf("PR %d: %w", prNumber, ErrStatusChecksFailed)
+}
+
+// Adds leftOpenMarker to the description of the pull request
+func (ghrc *GitHubRepoContext) markLeftOpen(ctx context.Context, prNumber int) error {
+	pr, err := getPullRequestHead(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+	if err != nil {
+		return fmt.Errorf("Error getting PR %d: %s", prNumber, err)
+	}
+	body := pr.Repository.PullRequest.Body
+	if strings.Contains(body, leftOpenMarker) {
+		return nil
+	}
+	if _, err := updatePullRequestBody(ctx, ghrc.client, pr.Repository.PullRequest.Id, body+"\n\n"+leftOpenMarker); err != nil {
+		return fmt.Errorf("Error marking PR %d as left open: %s", prNumber, err)
+	}
+	return nil
+}
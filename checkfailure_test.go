@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestHandleFailedChecksClose(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestHead": `{"repository":{"pullRequest":{"id":"PR_1","headRefName":"dora-the-explorer-1","headRefOid":"abc123","headRef":{"id":"REF_1"}}}}`,
+		"closePullRequest":   `{"closePullRequest":{"pullRequest":{"id":"PR_1","state":"CLOSED"}}}`,
+		"deleteRef":          `{"deleteRef":{"clientMutationId":null}}`,
+	})
+	ghrc.checkFailurePolicy = CheckFailurePolicyClose
+	ghrc.history = NewHistory(10, zap.NewNop())
+
+	err := ghrc.HandleFailedChecks(context.Background(), zap.NewNop(), 1, &defaultPersona, &ChangeTemplateData{})
+	if !errors.Is(err, ErrStatusChecksFailed) {
+		t.Fatalf("Expected ErrStatusChecksFailed, got %v", err)
+	}
+	if fake.calls["closePullRequest"]["pullRequestId"] != "PR_1" {
+		t.Errorf("Expected PR_1 to be closed")
+	}
+	if fake.calls["deleteRef"]["refId"] != "REF_1" {
+		t.Errorf("Expected REF_1 to be deleted")
+	}
+
+	events := ghrc.history.Recent(10)
+	if len(events) != 2 || events[0].Type != EventChecksFailed || events[1].Type != EventPRClosed {
+		t.Errorf("Expected checks_failed then pr_closed events, got %v", events)
+	}
+}
+
+func TestHandleFailedChecksLeave(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestHead":    `{"repository":{"pullRequest":{"id":"PR_1","body":"Bump the module","headRefName":"dora-the-explorer-1","headRefOid":"abc123","headRef":{"id":"REF_1"}}}}`,
+		"updatePullRequestBody": `{"updatePullRequest":{"pullRequest":{"id":"PR_1"}}}`,
+	})
+	ghrc.checkFailurePolicy = CheckFailurePolicyLeave
+	ghrc.history = NewHistory(10, zap.NewNop())
+
+	err := ghrc.HandleFailedChecks(context.Background(), zap.NewNop(), 1, &defaultPersona, &ChangeTemplateData{})
+	if !errors.Is(err, ErrStatusChecksFailed) {
+		t.Fatalf("Expected ErrStatusChecksFailed, got %v", err)
+	}
+	if fake.counts["closePullRequest"] != 0 || fake.counts["deleteRef"] != 0 {
+		t.Errorf("Expected the PR to be left open, got %v", fake.counts)
+	}
+	if body, _ := fake.calls["updatePullRequestBody"]["body"].(string); !strings.HasSuffix(body, leftOpenMarker) {
+		t.Errorf("Expected the PR to be marked as left open, got %q", body)
+	}
+	if events := ghrc.history.Recent(1); events[0].Type != EventPRLeftOpen {
+		t.Errorf("Expected a pr_left_open event, got %v", events)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attributes from the OpenTelemetry CI/CD, VCS and deployment semantic
+// conventions. They are newer than the semconv packages of the SDK, so they
+// are declared here.
+const (
+	attrCICDPipelineName          = attribute.Key("cicd.pipeline.name")
+	attrCICDPipelineRunID         = attribute.Key("cicd.pipeline.run.id")
+	attrCICDPipelineTaskType      = attribute.Key("cicd.pipeline.task.type")
+	attrCICDPipelineResult        = attribute.Key("cicd.pipeline.result")
+	attrVCSProviderName           = attribute.Key("vcs.provider.name")
+	attrVCSOwnerName              = attribute.Key("vcs.owner.name")
+	attrVCSRepositoryName         = attribute.Key("vcs.repository.name")
+	attrVCSRepositoryURLFull      = attribute.Key("vcs.repository.url.full")
+	attrVCSRefHeadName            = attribute.Key("vcs.ref.head.name")
+	attrVCSRefHeadType            = attribute.Key("vcs.ref.head.type")
+	attrVCSRefHeadRevision        = attribute.Key("vcs.ref.head.revision")
+	attrVCSRefBaseName            = attribute.Key("vcs.ref.base.name")
+	attrVCSRefBaseType            = attribute.Key("vcs.ref.base.type")
+	attrVCSRefBaseRevision        = attribute.Key("vcs.ref.base.revision")
+	attrVCSChangeID               = attribute.Key("vcs.change.id")
+	attrVCSChangeState            = attribute.Key("vcs.change.state")
+	attrDeploymentEnvironmentName = attribute.Key("deployment.environment.name")
+	attrDeploymentStatus          = attribute.Key("deployment.status")
+)
+
+// Attributes of the simulation that have no semantic convention
+const (
+	attrWorkItem        = attribute.Key("dora.work_item")
+	attrIntendedOutcome = attribute.Key("dora.intended_outcome")
+	attrIncidentID      = attribute.Key("dora.incident.id")
+	attrEventDetail     = attribute.Key("dora.event.detail")
+)
+
+// Emits the events of a change cycle as span events on its span, with the
+// CI/CD semantic convention attributes, so telemetry pipelines can be tested
+// against a known sequence of events. Methods on a nil *CICDEventEmitter do
+// nothing.
+type CICDEventEmitter struct {
+	repository []attribute.KeyValue
+
+	mu      sync.Mutex
+	span    trace.Span
+	headRef string
+	baseRef string
+}
+
+func NewCICDEventEmitter(ghrc *GitHubRepoContext) *CICDEventEmitter {
+	return &CICDEventEmitter{repository: []attribute.KeyValue{
+		attrCICDPipelineName.String("dora-the-explorer"),
+		attrVCSProviderName.String("github"),
+		attrVCSOwnerName.String(ghrc.org),
+		attrVCSRepositoryName.String(ghrc.name),
+		attrVCSRepositoryURLFull.String(strings.TrimSuffix(ghrc.remoteRepoUrl, ".git")),
+	}}
+}
+
+// Sets the span of the change cycle that following events are added to, nil
+// when no change is in progress. The refs of the previous change are cleared.
+func (c *CICDEventEmitter) SetSpan(span trace.Span) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.span = span
+	c.headRef, c.baseRef = "", ""
+}
+
+// Adds the event to the span of the change cycle in progress
+func (c *CICDEventEmitter) Observe(e Event) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e.HeadRef != "" {
+		c.headRef = e.HeadRef
+	}
+	if e.BaseRef != "" {
+		c.baseRef = e.BaseRef
+	}
+	if c.span == nil {
+		return
+	}
+	c.span.AddEvent(e.Type, trace.WithTimestamp(e.Time), trace.WithAttributes(c.attributes(e)...))
+}
+
+// Returns the semantic convention attributes of the event. SHAs before the
+// merge are revisions of the head ref, the merge commit and its deployments
+// revisions of the base ref.
+func (c *CICDEventEmitter) attributes(e Event) []attribute.KeyValue {
+	attrs := append([]attribute.KeyValue{}, c.repository...)
+	add := func(key attribute.Key, value string) {
+		if value != "" {
+			attrs = append(attrs, key.String(value))
+		}
+	}
+
+	add(attrCICDPipelineRunID, e.ChangeID)
+	add(attrWorkItem, e.WorkItem)
+	add(attrIntendedOutcome, e.IntendedOutcome)
+	if c.headRef != "" {
+		attrs = append(attrs, attrVCSRefHeadName.String(c.headRef), attrVCSRefHeadType.String("branch"))
+	}
+	if c.baseRef != "" {
+		attrs = append(attrs, attrVCSRefBaseName.String(c.baseRef), attrVCSRefBaseType.String("branch"))
+	}
+	if e.PRNumber != 0 {
+		attrs = append(attrs, attrVCSChangeID.String(strconv.Itoa(e.PRNumber)))
+	}
+
+	switch e.Type {
+	case EventPROpened, EventPRIdle, EventPRLeftOpen:
+		add(attrVCSChangeState, "open")
+	case EventChecksPassed, EventChecksRecovered:
+		add(attrVCSChangeState, "open")
+		add(attrCICDPipelineTaskType, "test")
+		add(attrCICDPipelineResult, "success")
+	case EventChecksFailed:
+		add(attrVCSChangeState, "open")
+		add(attrCICDPipelineTaskType, "test")
+		add(attrCICDPipelineResult, "failure")
+	case EventChecksRetried:
+		add(attrVCSChangeState, "open")
+		add(attrCICDPipelineTaskType, "test")
+	case EventPRClosed, EventPRAbandoned:
+		add(attrVCSChangeState, "closed")
+	case EventMerged:
+		add(attrVCSChangeState, "merged")
+	case EventDeployStarted:
+		add(attrCICDPipelineTaskType, "deploy")
+	case EventDeployed, EventRolledBack:
+		add(attrCICDPipelineTaskType, "deploy")
+		add(attrCICDPipelineResult, "success")
+		add(attrDeploymentStatus, "succeeded")
+	case EventDeployFailed:
+		add(attrCICDPipelineTaskType, "deploy")
+		add(attrCICDPipelineResult, "failure")
+		add(attrDeploymentStatus, "failed")
+	}
+
+	switch e.Type {
+	case EventBranchPushed, EventPROpened, EventChecksRetried, EventChecksRecovered:
+		add(attrVCSRefHeadRevision, e.SHA)
+	default:
+		add(attrVCSRefBaseRevision, e.SHA)
+	}
+	add(attrDeploymentEnvironmentName, e.Environment)
+	add(attrIncidentID, e.IncidentID)
+	add(attrEventDetail, e.Detail)
+	return attrs
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+func eventAttributes(attrs []attribute.KeyValue) map[attribute.Key]string {
+	values := map[attribute.Key]string{}
+	for _, attr := range attrs {
+		values[attr.Key] = attr.Value.Emit()
+	}
+	return values
+}
+
+func TestCICDEventEmitter(t *testing.T) {
+	recorder := recordSpans(t)
+	ghrc := &GitHubRepoContext{org: "test-org", name: "test-repo", remoteRepoUrl: "https://github.com/test-org/test-repo.git"}
+	emitter := NewCICDEventEmitter(ghrc)
+	history := NewHistory(10, zap.NewNop())
+	history.AddObserver(emitter.Observe)
+
+	history.Record(Event{Type: EventPRIdle})
+	_, span := tracer.Start(context.Background(), "change cycle")
+	emitter.SetSpan(span)
+	history.StartChange(&ChangeTemplateData{Epoch: 1718000000000, WorkItem: "DORA-1234"})
+	history.Record(Event{Type: EventBranchPushed, SHA: "def456", HeadRef: "dora-the-explorer-1", BaseRef: "main"})
+	history.Record(Event{Type: EventChecksFailed, PRNumber: 7})
+	history.Record(Event{Type: EventMerged, PRNumber: 7, SHA: "abc123"})
+	history.Record(Event{Type: EventDeployFailed, SHA: "abc123", Environment: "production"})
+	emitter.SetSpan(nil)
+	span.End()
+	history.Record(Event{Type: EventDeployed})
+
+	events := recorder.Ended()[0].Events()
+	if len(events) != 4 {
+		t.Fatalf("Expected only the events of the change cycle, got %v", events)
+	}
+
+	expected := []map[attribute.Key]string{
+		{attrVCSRefHeadName: "dora-the-explorer-1", attrVCSRefBaseName: "main", attrVCSRefHeadRevision: "def456", attrVCSRefBaseRevision: "", attrCICDPipelineRunID: "1718000000000", attrWorkItem: "DORA-1234"},
+		{attrVCSChangeID: "7", attrVCSChangeState: "open", attrCICDPipelineTaskType: "test", attrCICDPipelineResult: "failure"},
+		{attrVCSChangeState: "merged", attrVCSRefBaseRevision: "abc123", attrVCSRefHeadName: "dora-the-explorer-1"},
+		{attrDeploymentEnvironmentName: "production", attrDeploymentStatus: "failed", attrCICDPipelineResult: "failure"},
+	}
+	for i, event := range events {
+		values := eventAttributes(event.Attributes)
+		if values[attrVCSRepositoryURLFull] != "https://github.com/test-org/test-repo" || values[attrVCSOwnerName] != "test-org" {
+			t.Errorf("Expected the repository attributes on %s, got %v", event.Name, values)
+		}
+		for key, value := range expected[i] {
+			if values[key] != value {
+				t.Errorf("Expected %s=%s on %s, got %q", key, value, event.Name, values[key])
+			}
+		}
+	}
+	for _, attr := range events[1].Attributes {
+		if attr.Key == attrVCSChangeID && attr.Value.Type() != attribute.STRING {
+			t.Errorf("Expected %s to be a string, got %s", attrVCSChangeID, attr.Value.Type())
+		}
+	}
+	if events[3].Name != EventDeployFailed {
+		t.Errorf("Expected the event to be named after its type, got %s", events[3].Name)
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	DeployCheckName     = "name"     // Matches the name of a check run
+	DeployCheckWorkflow = "workflow" // Matches the workflow a check run belongs to
+	DeployCheckContext  = "context"  // Matches the context of a commit status
+)
+
+// Returned when a required deploy check does not show up on the commit
+var ErrDeployCheckNotFound = errors.New("Deploy check not found")
+
+// A check that has to succeed for a deployment to count as complete
+type DeployCheck struct {
+	Kind    string // DeployCheckName, DeployCheckWorkflow or DeployCheckContext
+	Pattern *regexp.Regexp
+}
+
+func (c DeployCheck) String() string {
+	return c.Kind + ":" + c.Pattern.String()
+}
+
+// Parses a comma separated list of required deploy checks, each a regular
+// expression prefixed with its kind, for example
+// "name:^deploy$,workflow:Deploy,context:ci/deploy". Checks without a kind
+// match on the check run name.
+func ParseDeployChecks(s string) ([]DeployCheck, error) {
+	var checks []DeployCheck
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		kind, expression := DeployCheckName, item
+		if k, e, found := strings.Cut(item, ":"); found {
+			switch k {
+			case DeployCheckName, DeployCheckWorkflow, DeployCheckContext:
+				kind, expression = k, e
+			}
+		}
+
+		pattern, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid deploy check %q: %s", item, err)
+		}
+		checks = append(checks, DeployCheck{Kind: kind, Pattern: pattern})
+	}
+
+	if len(checks) == 0 {
+		return nil, fmt.Errorf("No deploy checks found in %q", s)
+	}
+	return checks, nil
+}
+
+// Whether a check run or commit status on the deployed commit is done and
+// whether it succeeded
+type deployCheckState int
+
+const (
+	deployCheckPending deployCheckState = iota
+	deployCheckSucceeded
+	deployCheckFailed
+)
+
+// Returns whether the context matches the check and the context's state
+func (c DeployCheck) match(node GitHubAction) (bool, deployCheckState) {
+	switch n := node.(type) {
+	case *GitHubActionCheckRun:
+		var subject string
+		switch c.Kind {
+		case DeployCheckName:
+			subject = n.Name
+		case DeployCheckWorkflow:
+			subject = n.CheckSuite.WorkflowRun.Workflow.Name
+		}
+		if subject == "" || !c.Pattern.MatchString(subject) {
+			return false, deployCheckPending
+		}
+		switch {
+		case n.Status != CheckStatusStateCompleted:
+			return true, deployCheckPending
+		case n.Conclusion == CheckConclusionStateSuccess:
+			return true, deployCheckSucceeded
+		default:
+			return true, deployCheckFailed
+		}
+	case *GitHubActionStatusContext:
+		if c.Kind != DeployCheckContext || !c.Pattern.MatchString(n.Context) {
+			return false, deployCheckPending
+		}
+		switch n.State {
+		case StatusStateSuccess:
+			return true, deployCheckSucceeded
+		case StatusStateFailure, StatusStateError:
+			return true, deployCheckFailed
+		default:
+			return true, deployCheckPending
+		}
+	}
+	return false, deployCheckPending
+}
+
+// Evaluates the required checks against the contexts on the commit. Returns
+// done once every check matched at least one context and all matching contexts
+// succeeded, ErrDeploymentFailed as soon as one failed and the checks that
+// have not matched anything yet.
+func evaluateDeployChecks(checks []DeployCheck, nodes []GitHubAction) (done bool, missing []DeployCheck, err error) {
+	done = true
+	for _, check := range checks {
+		matched := false
+		for _, node := range nodes {
+			ok, state := check.match(node)
+			if !ok {
+				continue
+			}
+			matched = true
+			switch state {
+			case deployCheckFailed:
+				return false, nil, fmt.Errorf("%s: %w", check, ErrDeploymentFailed)
+			case deployCheckPending:
+				done = false
+			}
+		}
+		if !matched {
+			missing = append(missing, check)
+			done = false
+		}
+	}
+	return done, missing, nil
+}
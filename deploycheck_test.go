@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseDeployChecks(t *testing.T) {
+	checks, err := ParseDeployChecks("^deploy$, workflow:Deploy ,context:ci/deploy-.*")
+	if err != nil {
+		t.Fatalf("Error parsing deploy checks: %s", err)
+	}
+	expected := []string{"name:^deploy$", "workflow:Deploy", "context:ci/deploy-.*"}
+	if len(checks) != len(expected) {
+		t.Fatalf("Expected %d checks, got %v", len(expected), checks)
+	}
+	for i := range expected {
+		if checks[i].String() != expected[i] {
+			t.Errorf("Expected check %d to be %s, got %s", i, expected[i], checks[i])
+		}
+	}
+
+	for _, invalid := range []string{"", "name:(", " , "} {
+		if _, err := ParseDeployChecks(invalid); err == nil {
+			t.Errorf("Expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestEvaluateDeployChecks(t *testing.T) {
+	checks, _ := ParseDeployChecks("name:^deploy$,context:ci/smoke")
+
+	deploy := &GitHubActionCheckRun{Name: "deploy", Status: CheckStatusStateInProgress}
+	smoke := &GitHubActionStatusContext{Context: "ci/smoke", State: StatusStatePending}
+	other := &GitHubActionCheckRun{Name: "deploy-docs", Status: CheckStatusStateCompleted, Conclusion: CheckConclusionStateFailure}
+
+	done, missing, err := evaluateDeployChecks(checks, []GitHubAction{deploy, other})
+	if done || err != nil || len(missing) != 1 || missing[0].Kind != DeployCheckContext {
+		t.Errorf("Expected to wait for the missing status context, got %v %v %v", done, missing, err)
+	}
+
+	deploy.Status, deploy.Conclusion = CheckStatusStateCompleted, CheckConclusionStateSuccess
+	done, _, err = evaluateDeployChecks(checks, []GitHubAction{deploy, smoke, other})
+	if done || err != nil {
+		t.Errorf("Expected to wait for the pending status context, got %v %v", done, err)
+	}
+
+	smoke.State = StatusStateSuccess
+	done, _, err = evaluateDeployChecks(checks, []GitHubAction{deploy, smoke, other})
+	if !done || err != nil {
+		t.Errorf("Expected the deployment to be complete, got %v %v", done, err)
+	}
+
+	smoke.State = StatusStateFailure
+	_, _, err = evaluateDeployChecks(checks, []GitHubAction{deploy, smoke})
+	if !errors.Is(err, ErrDeploymentFailed) {
+		t.Errorf("Expected ErrDeploymentFailed, got %v", err)
+	}
+
+	byWorkflow, _ := ParseDeployChecks("workflow:^Deploy$")
+	run := &GitHubActionCheckRun{Name: "release", Status: CheckStatusStateCompleted, Conclusion: CheckConclusionStateSuccess}
+	run.CheckSuite.WorkflowRun.Workflow.Name = "Deploy"
+	if done, _, err := evaluateDeployChecks(byWorkflow, []GitHubAction{run}); !done || err != nil {
+		t.Errorf("Expected the check run to match by workflow, got %v %v", done, err)
+	}
+}
+
+func TestGetCommitContexts(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, nil)
+	fake.pages["getCommitGitHubActionsRuns"] = []string{
+		`{"repository":{"object":{"__typename":"Commit","statusCheckRollup":{"contexts":{
+			"pageInfo":{"hasNextPage":true,"endCursor":"page2"},
+			"nodes":[{"__typename":"CheckRun","name":"test","status":"COMPLETED","conclusion":"SUCCESS"}]}}}}}`,
+		`{"repository":{"object":{"__typename":"Commit","statusCheckRollup":{"contexts":{
+			"pageInfo":{"hasNextPage":false,"endCursor":""},
+			"nodes":[{"__typename":"StatusContext","context":"ci/deploy","state":"SUCCESS"}]}}}}}`,
+	}
+
+	nodes, err := ghrc.getCommitContexts(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("Error getting commit contexts: %s", err)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("Expected contexts from both pages, got %v", nodes)
+	}
+	if status, ok := nodes[1].(*GitHubActionStatusContext); !ok || status.Context != "ci/deploy" {
+		t.Errorf("Expected the status context from the second page, got %v", nodes[1])
+	}
+	if fake.calls["getCommitGitHubActionsRuns"]["cursor"] != "page2" {
+		t.Errorf("Expected the second page to be requested, got %v", fake.calls["getCommitGitHubActionsRuns"])
+	}
+}
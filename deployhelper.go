@@ -0,0 +1,388 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DeployModeWorkflow = "workflow" // Wait for the target repo's deploy workflow
+	DeployModeAPI      = "api"      // Create the deployment and its statuses directly
+)
+
+const (
+	RecoveryStrategyForward  = "forward"  // Recover with the next generated change
+	RecoveryStrategyRollback = "rollback" // Redeploy the last known-good commit
+)
+
+// Returned when a deployment finishes unsuccessfully
+var ErrDeploymentFailed = errors.New("Deployment failed")
+
+// A failed deployment of a pipeline, wraps ErrDeploymentFailed
+type DeploymentError struct {
+	Environment string
+	SHA         string
+	FailedAt    time.Time
+}
+
+func (e *DeploymentError) Error() string {
+	return fmt.Sprintf("%s to %s: %s", e.SHA, e.Environment, ErrDeploymentFailed)
+}
+
+func (e *DeploymentError) Unwrap() error {
+	return ErrDeploymentFailed
+}
+
+// One environment of a deployment pipeline
+type EnvironmentStage struct {
+	Name        string
+	SoakMinutes Range   // Soak or approval delay before deploying to this environment
+	FailureRate float64 // Percentage of deployments to this environment that fail
+}
+
+// Deploys sha through the profile's pipeline in order. Each environment gets
+// its own deployment after its soak delay, and the change is only promoted to
+// the next environment when the deployment succeeds. Besides each
+// environment's own failure chance, a change intended to fail fails in the
+// last environment.
+//
+// Returns a *DeploymentError when a deployment failed.
+func (ghrc *GitHubRepoContext) DeployPipeline(
+	ctx context.Context,
+	logger *zap.Logger,
+	sha string,
+	profile DeployProfile,
+	data *ChangeTemplateData) error {
+
+	for i, stage := range profile.Pipeline {
+		soak := time.Duration(stage.SoakMinutes.Sample()) * time.Minute
+		if soak > 0 {
+			logger.Sugar().Infof("Promoting %s to %s in %s", sha, stage.Name, soak)
+			if err := ghrc.sleep(ctx, soak); err != nil {
+				return err
+			}
+		}
+
+		//nolint:gosec // No security issue, just need a psudo-random outcome
+		fail := rand.Float64()*100 < stage.FailureRate ||
+			(i == len(profile.Pipeline)-1 && data.IntendedOutcome == IntendedOutcomeFailure)
+
+		ghrc.history.Record(Event{Type: EventDeployStarted, SHA: sha, Environment: stage.Name})
+		err := ghrc.CreateDeployment(ctx, logger, sha, stage.Name, profile, data, fail)
+		if errors.Is(err, ErrDeploymentFailed) {
+			failure := &DeploymentError{Environment: stage.Name, SHA: sha, FailedAt: time.Now()}
+			ghrc.recordDeployResult(stage.Name, sha, failure.FailedAt, true)
+			return failure
+		}
+		if err != nil {
+			return err
+		}
+		ghrc.rememberGoodDeployment(stage.Name, sha)
+		ghrc.recordDeployResult(stage.Name, sha, time.Now(), false)
+	}
+	return nil
+}
+
+// Records the outcome of a deployment. A failed deployment opens an incident
+// for the environment unless one is open already, and the next successful
+// deployment to the environment closes it.
+func (ghrc *GitHubRepoContext) recordDeployResult(environment string, sha string, at time.Time, failed bool) {
+	if ghrc.openIncidents == nil {
+		ghrc.openIncidents = map[string]Event{}
+	}
+	incident, open := ghrc.openIncidents[environment]
+
+	if failed {
+		ghrc.history.Record(Event{Time: at, Type: EventDeployFailed, SHA: sha, Environment: environment})
+		if !open {
+			incident = Event{
+				Time:        at,
+				Type:        EventIncidentOpened,
+				SHA:         sha,
+				Environment: environment,
+				IncidentID:  fmt.Sprintf("%s-%d", environment, at.UnixMilli()),
+			}
+			ghrc.openIncidents[environment] = incident
+			ghrc.history.Record(incident)
+		}
+		return
+	}
+
+	ghrc.history.Record(Event{Time: at, Type: EventDeployed, SHA: sha, Environment: environment})
+	if open {
+		delete(ghrc.openIncidents, environment)
+		ghrc.history.Record(Event{
+			Time:        at,
+			Type:        EventIncidentClosed,
+			SHA:         sha,
+			Environment: environment,
+			IncidentID:  incident.IncidentID,
+			Detail:      fmt.Sprintf("failed %s, restored after %s", incident.SHA, at.Sub(incident.Time).Round(time.Second)),
+		})
+	}
+}
+
+// Recovers from a failed deployment by redeploying the last known-good commit
+// to the environment after a delay sampled from the profile. The failed
+// deployment keeps its FAILURE state and the rollback deployment succeeds,
+// which makes GitHub mark older deployments to the environment inactive. The
+// incident window, from the failure until the rollback succeeded, is recorded.
+//
+// The last known-good commit is the last one deployed to the environment, or
+// the parent of the failed commit when nothing was deployed since starting.
+func (ghrc *GitHubRepoContext) Rollback(
+	ctx context.Context,
+	logger *zap.Logger,
+	failure *DeploymentError,
+	profile DeployProfile,
+	data *ChangeTemplateData) error {
+
+	goodSha, err := ghrc.lastGoodDeployment(ctx, failure.Environment, failure.SHA)
+	if err != nil {
+		return err
+	}
+
+	delay := time.Duration(profile.RecoveryMinutes.Sample()) * time.Minute
+	logger.Sugar().Infof("Rolling %s back to %s in %s", failure.Environment, goodSha, delay)
+	if err := ghrc.sleep(ctx, delay); err != nil {
+		return err
+	}
+
+	rollback := &ChangeTemplateData{ChangeType: "rollback", FromVersion: data.ToVersion, ToVersion: data.FromVersion}
+	if err := ghrc.CreateDeployment(ctx, logger, goodSha, failure.Environment, profile, rollback, false); err != nil {
+		return fmt.Errorf("Error rolling back %s: %s", failure.Environment, err)
+	}
+
+	window := time.Since(failure.FailedAt).Round(time.Second)
+	logger.Sugar().Infof("Rolled %s back to %s, incident lasted %s", failure.Environment, goodSha, window)
+	ghrc.history.Record(Event{
+		Type:        EventRolledBack,
+		SHA:         goodSha,
+		Environment: failure.Environment,
+		Detail:      fmt.Sprintf("%s failed at %s, recovered after %s", failure.SHA, failure.FailedAt.Format(time.RFC3339), window),
+	})
+	ghrc.recordDeployResult(failure.Environment, goodSha, time.Now(), false)
+	return nil
+}
+
+func (ghrc *GitHubRepoContext) rememberGoodDeployment(environment string, sha string) {
+	if ghrc.goodDeployments == nil {
+		ghrc.goodDeployments = map[string]string{}
+	}
+	ghrc.goodDeployments[environment] = sha
+}
+
+// Returns the last commit successfully deployed to the environment, falling
+// back to the parent of the failed commit
+func (ghrc *GitHubRepoContext) lastGoodDeployment(ctx context.Context, environment string, failedSha string) (string, error) {
+	if sha, ok := ghrc.goodDeployments[environment]; ok && sha != failedSha {
+		return sha, nil
+	}
+
+	parent, err := getCommitParent(ctx, ghrc.client, ghrc.org, ghrc.name, failedSha)
+	if err != nil {
+		return "", fmt.Errorf("Error getting parent of %s: %s", failedSha, err)
+	}
+	commit, ok := parent.Repository.Object.(*getCommitParentRepositoryObjectCommit)
+	if !ok || len(commit.Parents.Nodes) == 0 {
+		return "", fmt.Errorf("No known-good commit to roll %s back to", environment)
+	}
+	return commit.Parents.Nodes[0].Oid, nil
+}
+
+// Creates a deployment of sha to the environment through the Deployments API
+// and walks it through the queued, in progress and success or failure states
+// with durations sampled from the profile. No workflow is needed in the
+// target repo.
+//
+// createDeployment only accepts a ref, so a temporary branch is created at sha
+// and deleted once the deployment exists.
+func (ghrc *GitHubRepoContext) CreateDeployment(
+	ctx context.Context,
+	logger *zap.Logger,
+	sha string,
+	environment string,
+	profile DeployProfile,
+	data *ChangeTemplateData,
+	fail bool) error {
+
+	repoId, err := getRepoId(ctx, ghrc.client, ghrc.org, ghrc.name)
+	if err != nil {
+		return fmt.Errorf("Error getting repository ID: %s", err)
+	}
+
+	tempBranch := fmt.Sprintf("%sdeploy-%d", ghrc.templates.BranchPrefix, time.Now().UnixMilli())
+	tempRef, err := createRef(ctx, ghrc.client, repoId.Repository.Id, "refs/heads/"+tempBranch, sha)
+	if err != nil {
+		return fmt.Errorf("Error creating %s: %s", tempBranch, err)
+	}
+
+	deployment, err := createDeployment(ctx,
+		ghrc.client,
+		repoId.Repository.Id,
+		tempRef.CreateRef.Ref.Id,
+		environment,
+		fmt.Sprintf("%s %s -> %s", data.ChangeType, data.FromVersion, data.ToVersion))
+	// The deployment keeps its commit, the branch is not needed past this point
+	if _, err := deleteRef(ctx, ghrc.client, tempRef.CreateRef.Ref.Id); err != nil {
+		logger.Sugar().Errorf("Error deleting %s: %s", tempBranch, err)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating deployment: %s", err)
+	}
+	deploymentId := deployment.CreateDeployment.Deployment.Id
+	logger.Sugar().Infof("Created deployment of %s to %s", sha, environment)
+
+	final := DeploymentStatusStateSuccess
+	if fail {
+		final = DeploymentStatusStateFailure
+	}
+	steps := []struct {
+		state DeploymentStatusState
+		wait  time.Duration
+	}{
+		{DeploymentStatusStateQueued, time.Duration(profile.QueuedSeconds.Sample()) * time.Second},
+		{DeploymentStatusStateInProgress, time.Duration(profile.DeployMinutes.Sample()) * time.Minute},
+		{final, 0},
+	}
+	for _, step := range steps {
+		_, err := createDeploymentStatus(ctx, ghrc.client, deploymentId, step.state, "Deployed by Dora the Explorer")
+		if err != nil {
+			return fmt.Errorf("Error setting deployment state to %s: %s", step.state, err)
+		}
+		logger.Sugar().Infof("Deployment to %s is %s", environment, step.state)
+		if err := ghrc.sleep(ctx, step.wait); err != nil {
+			return err
+		}
+	}
+
+	if final == DeploymentStatusStateFailure {
+		return ErrDeploymentFailed
+	}
+	return nil
+}
+
+// Parses a comma separated deployment pipeline of `name:soak:failureRate`
+// stages, for example "dev,staging:30-120:5,production:60-240:10". The soak
+// is a range of minutes, both it and the failure rate may be left out.
+func ParsePipeline(s string) ([]EnvironmentStage, error) {
+	var pipeline []EnvironmentStage
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.Split(item, ":")
+		if len(parts) > 3 {
+			return nil, fmt.Errorf("Invalid stage %q", item)
+		}
+		stage := EnvironmentStage{Name: strings.TrimSpace(parts[0])}
+		if stage.Name == "" {
+			return nil, fmt.Errorf("Invalid stage %q: missing environment", item)
+		}
+		if len(parts) > 1 && parts[1] != "" {
+			var err error
+			if stage.SoakMinutes, err = ParseRange(parts[1]); err != nil {
+				return nil, fmt.Errorf("Invalid soak for %s: %s", stage.Name, err)
+			}
+		}
+		if len(parts) > 2 {
+			var err error
+			if stage.FailureRate, err = strconv.ParseFloat(strings.TrimSpace(parts[2]), 64); err != nil {
+				return nil, fmt.Errorf("Invalid failure rate for %s: %s", stage.Name, err)
+			}
+		}
+		pipeline = append(pipeline, stage)
+	}
+
+	if len(pipeline) == 0 {
+		return nil, fmt.Errorf("No environments found in %q", s)
+	}
+	return pipeline, nil
+}
+
+// Selects the deployments that count towards the deployment cadence
+type DeploymentFilter struct {
+	Environment string                  // Only deployments to this environment, any when empty
+	States      []DeploymentStatusState // Only deployments that reached one of these states, any when empty
+}
+
+// Whether a deployment recorded in the history matches the filter
+func (f DeploymentFilter) matchesEvent(e Event) bool {
+	var state DeploymentStatusState
+	switch e.Type {
+	case EventDeployed:
+		state = DeploymentStatusStateSuccess
+	case EventDeployFailed:
+		state = DeploymentStatusStateFailure
+	default:
+		return false
+	}
+	if f.Environment != "" && e.Environment != f.Environment {
+		return false
+	}
+	return len(f.States) == 0 || slices.Contains(f.States, state)
+}
+
+// A deployment matching a DeploymentFilter
+type LastDeployment struct {
+	Deployment *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment
+	DeployedAt time.Time // When the deployment last reached a matching state
+}
+
+// Returns when the deployment last reached one of the filter's states, based on
+// its statuses. Without a state filter any status counts, and a deployment
+// without statuses counts from when it was created.
+func (f DeploymentFilter) deployedAt(d *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) (time.Time, bool) {
+	var deployedAt time.Time
+	for _, status := range d.Statuses.Nodes {
+		if len(f.States) > 0 && !slices.Contains(f.States, status.State) {
+			continue
+		}
+		if status.UpdatedAt.After(deployedAt) {
+			deployedAt = status.UpdatedAt
+		}
+	}
+
+	if deployedAt.IsZero() && len(f.States) == 0 {
+		return d.CreatedAt, true
+	}
+	return deployedAt, !deployedAt.IsZero()
+}
+
+// Parses a comma separated list of deployment status states, for example
+// "success,in_progress". "any" matches every state.
+func ParseDeploymentStates(s string) ([]DeploymentStatusState, error) {
+	if strings.EqualFold(strings.TrimSpace(s), "any") {
+		return nil, nil
+	}
+
+	var states []DeploymentStatusState
+	for _, item := range strings.Split(s, ",") {
+		state := DeploymentStatusState(strings.ToUpper(strings.TrimSpace(item)))
+		switch state {
+		case "":
+			continue
+		case DeploymentStatusStateError, DeploymentStatusStateFailure, DeploymentStatusStateInactive,
+			DeploymentStatusStateInProgress, DeploymentStatusStatePending, DeploymentStatusStateQueued,
+			DeploymentStatusStateSuccess, DeploymentStatusStateWaiting:
+			states = append(states, state)
+		default:
+			return nil, fmt.Errorf("Unknown deployment state: %s", item)
+		}
+	}
+
+	if len(states) == 0 {
+		return nil, fmt.Errorf("No states found in %q", s)
+	}
+	return states, nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var deploymentResponses = map[string]string{
+	"getRepoId":              `{"repository":{"id":"REPO_1"}}`,
+	"createRef":              `{"createRef":{"ref":{"id":"REF_1","name":"refs/heads/dora-the-explorer-deploy-1"}}}`,
+	"deleteRef":              `{"deleteRef":{"clientMutationId":null}}`,
+	"createDeployment":       `{"createDeployment":{"deployment":{"id":"DEP_1","commitOid":"abc123"}}}`,
+	"createDeploymentStatus": `{"createDeploymentStatus":{"deploymentStatus":{"id":"DS_1","state":"SUCCESS"}}}`,
+}
+
+func TestCreateDeployment(t *testing.T) {
+	data := &ChangeTemplateData{ChangeType: "upgrade", FromVersion: "v0.3.0", ToVersion: "v0.6.2"}
+
+	fake, ghrc := newFakeGitHub(t, deploymentResponses)
+	err := ghrc.CreateDeployment(context.Background(), zap.NewNop(), "abc123", "dev", DeployProfile{}, data, false)
+	if err != nil {
+		t.Fatalf("Error creating deployment: %s", err)
+	}
+	if fake.calls["createRef"]["oid"] != "abc123" {
+		t.Errorf("Expected the deploy ref to point at abc123, got %v", fake.calls["createRef"])
+	}
+	if fake.calls["createDeployment"]["refId"] != "REF_1" || fake.calls["createDeployment"]["environment"] != "dev" {
+		t.Errorf("Expected a deployment of REF_1 to dev, got %v", fake.calls["createDeployment"])
+	}
+	if fake.counts["createDeploymentStatus"] != 3 {
+		t.Errorf("Expected queued, in progress and final statuses, got %d", fake.counts["createDeploymentStatus"])
+	}
+	if fake.calls["createDeploymentStatus"]["state"] != "SUCCESS" {
+		t.Errorf("Expected the deployment to succeed, got %v", fake.calls["createDeploymentStatus"])
+	}
+	if fake.calls["deleteRef"]["refId"] != "REF_1" {
+		t.Errorf("Expected the deploy ref to be deleted, got %v", fake.calls["deleteRef"])
+	}
+
+	fake, ghrc = newFakeGitHub(t, deploymentResponses)
+	err = ghrc.CreateDeployment(context.Background(), zap.NewNop(), "abc123", "dev", DeployProfile{}, data, true)
+	if !errors.Is(err, ErrDeploymentFailed) {
+		t.Errorf("Expected ErrDeploymentFailed, got %v", err)
+	}
+	if fake.calls["createDeploymentStatus"]["state"] != "FAILURE" {
+		t.Errorf("Expected the deployment to fail, got %v", fake.calls["createDeploymentStatus"])
+	}
+}
+
+func TestDeployPipeline(t *testing.T) {
+	profile := DeployProfile{Pipeline: []EnvironmentStage{{Name: "dev"}, {Name: "staging"}, {Name: "production"}}}
+	data := &ChangeTemplateData{IntendedOutcome: IntendedOutcomeSuccess}
+
+	fake, ghrc := newFakeGitHub(t, deploymentResponses)
+	ghrc.history = NewHistory(10, zap.NewNop())
+	if err := ghrc.DeployPipeline(context.Background(), zap.NewNop(), "abc123", profile, data); err != nil {
+		t.Fatalf("Error deploying: %s", err)
+	}
+	if fake.counts["createDeployment"] != 3 || fake.calls["createDeployment"]["environment"] != "production" {
+		t.Errorf("Expected deployments to every environment ending in production, got %d", fake.counts["createDeployment"])
+	}
+
+	// A change intended to fail is promoted until the last environment
+	data.IntendedOutcome = IntendedOutcomeFailure
+	_, ghrc = newFakeGitHub(t, deploymentResponses)
+	ghrc.history = NewHistory(10, zap.NewNop())
+	err := ghrc.DeployPipeline(context.Background(), zap.NewNop(), "abc123", profile, data)
+	if !errors.Is(err, ErrDeploymentFailed) {
+		t.Fatalf("Expected ErrDeploymentFailed, got %v", err)
+	}
+	var deployed []string
+	for _, e := range ghrc.history.Recent(10) {
+		if e.Type == EventDeployed || e.Type == EventDeployFailed || e.Type == EventIncidentOpened {
+			deployed = append(deployed, e.Type+" "+e.Environment)
+		}
+	}
+	expected := []string{"deployed dev", "deployed staging", "deploy_failed production", "incident_opened production"}
+	if strings.Join(deployed, ", ") != strings.Join(expected, ", ") {
+		t.Errorf("Expected dev and staging to succeed and production to fail, got %v", deployed)
+	}
+
+	// A failing environment stops the promotion
+	data.IntendedOutcome = IntendedOutcomeSuccess
+	profile.Pipeline[0].FailureRate = 100
+	fake, ghrc = newFakeGitHub(t, deploymentResponses)
+	err = ghrc.DeployPipeline(context.Background(), zap.NewNop(), "abc123", profile, data)
+	if !errors.Is(err, ErrDeploymentFailed) {
+		t.Fatalf("Expected ErrDeploymentFailed, got %v", err)
+	}
+	if fake.counts["createDeployment"] != 1 {
+		t.Errorf("Expected promotion to stop after dev, got %d deployments", fake.counts["createDeployment"])
+	}
+}
+
+func TestParsePipeline(t *testing.T) {
+	pipeline, err := ParsePipeline("dev, staging:30-120:5 ,production::10")
+	if err != nil {
+		t.Fatalf("Error parsing pipeline: %s", err)
+	}
+	expected := []EnvironmentStage{
+		{Name: "dev"},
+		{Name: "staging", SoakMinutes: Range{LowerBound: 30, UpperBound: 120}, FailureRate: 5},
+		{Name: "production", FailureRate: 10},
+	}
+	if len(pipeline) != len(expected) {
+		t.Fatalf("Expected %d stages, got %v", len(expected), pipeline)
+	}
+	for i := range expected {
+		if pipeline[i] != expected[i] {
+			t.Errorf("Expected stage %d to be %v, got %v", i, expected[i], pipeline[i])
+		}
+	}
+
+	for _, invalid := range []string{"", ":5-10", "dev:soon", "dev:1-2:often", "dev:1:2:3"} {
+		if _, err := ParsePipeline(invalid); err == nil {
+			t.Errorf("Expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestRollback(t *testing.T) {
+	data := &ChangeTemplateData{FromVersion: "v0.3.0", ToVersion: "v0.6.2"}
+	failure := &DeploymentError{Environment: "production", SHA: "bad456", FailedAt: time.Now().Add(-time.Hour)}
+
+	// Roll back to the last commit deployed to the environment
+	fake, ghrc := newFakeGitHub(t, deploymentResponses)
+	ghrc.history = NewHistory(10, zap.NewNop())
+	ghrc.rememberGoodDeployment("production", "good123")
+	ghrc.recordDeployResult("production", "bad456", failure.FailedAt, true)
+	if err := ghrc.Rollback(context.Background(), zap.NewNop(), failure, DeployProfile{}, data); err != nil {
+		t.Fatalf("Error rolling back: %s", err)
+	}
+	if fake.calls["createRef"]["oid"] != "good123" || fake.calls["createDeployment"]["environment"] != "production" {
+		t.Errorf("Expected good123 to be redeployed to production, got %v %v", fake.calls["createRef"], fake.calls["createDeployment"])
+	}
+	if fake.calls["createDeploymentStatus"]["state"] != "SUCCESS" {
+		t.Errorf("Expected the rollback to succeed, got %v", fake.calls["createDeploymentStatus"])
+	}
+	events := ghrc.history.Recent(4)
+	if events[1].Type != EventRolledBack || events[1].SHA != "good123" {
+		t.Errorf("Expected the rollback to be recorded, got %v", events)
+	}
+	if events[3].Type != EventIncidentClosed || events[3].IncidentID != events[0].IncidentID || events[3].IncidentID == "" {
+		t.Errorf("Expected the rollback to close the incident, got %v", events)
+	}
+
+	// Without a known-good deployment, roll back to the parent of the failed commit
+	responses := map[string]string{
+		"getCommitParent": `{"repository":{"object":{"__typename":"Commit","parents":{"nodes":[{"oid":"parent789"}]}}}}`,
+	}
+	for op, response := range deploymentResponses {
+		responses[op] = response
+	}
+	fake, ghrc = newFakeGitHub(t, responses)
+	if err := ghrc.Rollback(context.Background(), zap.NewNop(), failure, DeployProfile{}, data); err != nil {
+		t.Fatalf("Error rolling back: %s", err)
+	}
+	if fake.calls["getCommitParent"]["oid"] != "bad456" || fake.calls["createRef"]["oid"] != "parent789" {
+		t.Errorf("Expected the parent of bad456 to be redeployed, got %v", fake.calls["createRef"])
+	}
+}
+
+func TestParseDeploymentStates(t *testing.T) {
+	states, err := ParseDeploymentStates("success, in_progress")
+	if err != nil {
+		t.Fatalf("Error parsing states: %s", err)
+	}
+	if len(states) != 2 || states[0] != DeploymentStatusStateSuccess || states[1] != DeploymentStatusStateInProgress {
+		t.Errorf("Expected SUCCESS and IN_PROGRESS, got %v", states)
+	}
+
+	if states, err := ParseDeploymentStates("any"); err != nil || states != nil {
+		t.Errorf("Expected any to match every state, got %v %v", states, err)
+	}
+
+	for _, invalid := range []string{"", "deployed"} {
+		if _, err := ParseDeploymentStates(invalid); err == nil {
+			t.Errorf("Expected an error parsing %q", invalid)
+		}
+	}
+}
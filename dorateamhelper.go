@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,11 +14,85 @@ type Range struct {
 	UpperBound int
 }
 
+// Returns a random value between the lower and upper bound, inclusive
+func (r Range) Sample() int {
+	if r.UpperBound <= r.LowerBound {
+		return r.LowerBound
+	}
+	//nolint:gosec // No security issue, just need a psudo-random value
+	return r.LowerBound + rand.Intn(r.UpperBound-r.LowerBound+1)
+}
+
+// Parses a range in the form "lower-upper", ie: "5-60". A single number is a
+// range with equal bounds.
+func ParseRange(s string) (Range, error) {
+	lower, upper, found := strings.Cut(strings.TrimSpace(s), "-")
+	if !found {
+		upper = lower
+	}
+
+	lowerBound, err := strconv.Atoi(strings.TrimSpace(lower))
+	if err != nil {
+		return Range{}, fmt.Errorf("Invalid range %q: %s", s, err)
+	}
+	upperBound, err := strconv.Atoi(strings.TrimSpace(upper))
+	if err != nil {
+		return Range{}, fmt.Errorf("Invalid range %q: %s", s, err)
+	}
+	if lowerBound < 0 || upperBound < lowerBound {
+		return Range{}, fmt.Errorf("Invalid range %q: bounds must be positive and ordered", s)
+	}
+
+	return Range{LowerBound: lowerBound, UpperBound: upperBound}, nil
+}
+
 type DoraTeam struct {
 	Level                     string
 	MinutesBetweenDeployRange Range
+	ChangeFailureRate         float64 // Percentage of changes intended to fail
+	Review                    ReviewProfile
+	MergeMethods              []Weighted[PullRequestMergeMethod]
+	Idle                      IdleProfile
+	Deploy                    DeployProfile
+}
+
+// How long the team's deployments take when Dora the Explorer creates them
+// through the Deployments API
+type DeployProfile struct {
+	QueuedSeconds   Range // From creating the deployment until it starts
+	DeployMinutes   Range // How long the deployment runs
+	Pipeline        []EnvironmentStage
+	RecoveryMinutes Range // From a failed deployment until it is rolled back
+}
+
+// How often the team's pull requests are abandoned or left sitting idle
+type IdleProfile struct {
+	AbandonRate         float64 // Percentage of pull requests closed unmerged
+	AbandonAfterMinutes Range   // How long an abandoned pull request stays open
+	IdleRate            float64 // Percentage of pull requests left idle before review
+	IdleMinutes         Range   // How long an idle pull request sits untouched
+}
+
+const (
+	PullRequestFateMerge   = "merge"
+	PullRequestFateIdle    = "idle"
+	PullRequestFateAbandon = "abandon"
+)
+
+// How long reviews of the team's pull requests take and how often they need
+// another round
+type ReviewProfile struct {
+	PickupMinutes        Range   // From requesting a review until the reviewer starts
+	ReviewMinutes        Range   // Time spent on a review, and on addressing feedback
+	ChangesRequestedRate float64 // Percentage of reviews that request changes
+	Reviewers            int     // Number of reviewers requested per pull request
 }
 
+const (
+	IntendedOutcomeSuccess = "success"
+	IntendedOutcomeFailure = "failure"
+)
+
 //	 Performance level					Elite:
 //		Deployment Frequency: 				On-demand (multiple deploys per day)
 //		Change lead time: 					Less than one day
@@ -32,6 +108,28 @@ func NewEliteDoraTeam() *DoraTeam {
 			LowerBound: 60,
 			UpperBound: 720,
 		},
+		ChangeFailureRate: 5,
+		Review: ReviewProfile{
+			PickupMinutes:        Range{LowerBound: 5, UpperBound: 60},
+			ReviewMinutes:        Range{LowerBound: 5, UpperBound: 30},
+			ChangesRequestedRate: 10,
+			Reviewers:            1,
+		},
+		MergeMethods: []Weighted[PullRequestMergeMethod]{
+			{Value: PullRequestMergeMethodSquash, Weight: 1},
+		},
+		Idle: IdleProfile{
+			AbandonRate:         5,
+			AbandonAfterMinutes: Range{LowerBound: 60, UpperBound: 480},
+			IdleRate:            0,
+			IdleMinutes:         Range{LowerBound: 0, UpperBound: 0},
+		},
+		Deploy: DeployProfile{
+			QueuedSeconds:   Range{LowerBound: 5, UpperBound: 30},
+			DeployMinutes:   Range{LowerBound: 2, UpperBound: 10},
+			Pipeline:        []EnvironmentStage{{Name: "dev"}},
+			RecoveryMinutes: Range{LowerBound: 10, UpperBound: 60},
+		},
 	}
 }
 
@@ -47,6 +145,28 @@ func NewHighDoraTeam() *DoraTeam {
 			LowerBound: 1440,  // 24 hours
 			UpperBound: 10080, // 7 days
 		},
+		ChangeFailureRate: 10,
+		Review: ReviewProfile{
+			PickupMinutes:        Range{LowerBound: 30, UpperBound: 240},
+			ReviewMinutes:        Range{LowerBound: 15, UpperBound: 60},
+			ChangesRequestedRate: 20,
+			Reviewers:            1,
+		},
+		MergeMethods: []Weighted[PullRequestMergeMethod]{
+			{Value: PullRequestMergeMethodSquash, Weight: 1},
+		},
+		Idle: IdleProfile{
+			AbandonRate:         10,
+			AbandonAfterMinutes: Range{LowerBound: 1440, UpperBound: 4320},
+			IdleRate:            10,
+			IdleMinutes:         Range{LowerBound: 1440, UpperBound: 4320},
+		},
+		Deploy: DeployProfile{
+			QueuedSeconds:   Range{LowerBound: 10, UpperBound: 60},
+			DeployMinutes:   Range{LowerBound: 5, UpperBound: 15},
+			Pipeline:        []EnvironmentStage{{Name: "dev"}},
+			RecoveryMinutes: Range{LowerBound: 60, UpperBound: 1440},
+		},
 	}
 }
 
@@ -62,6 +182,28 @@ func NewMediumDoraTeam() *DoraTeam {
 			LowerBound: 10080, // 1 week
 			UpperBound: 40320, // 4 weeks
 		},
+		ChangeFailureRate: 15,
+		Review: ReviewProfile{
+			PickupMinutes:        Range{LowerBound: 240, UpperBound: 1440},
+			ReviewMinutes:        Range{LowerBound: 30, UpperBound: 120},
+			ChangesRequestedRate: 30,
+			Reviewers:            1,
+		},
+		MergeMethods: []Weighted[PullRequestMergeMethod]{
+			{Value: PullRequestMergeMethodSquash, Weight: 1},
+		},
+		Idle: IdleProfile{
+			AbandonRate:         15,
+			AbandonAfterMinutes: Range{LowerBound: 2880, UpperBound: 10080},
+			IdleRate:            20,
+			IdleMinutes:         Range{LowerBound: 2880, UpperBound: 10080},
+		},
+		Deploy: DeployProfile{
+			QueuedSeconds:   Range{LowerBound: 30, UpperBound: 120},
+			DeployMinutes:   Range{LowerBound: 10, UpperBound: 30},
+			Pipeline:        []EnvironmentStage{{Name: "dev"}},
+			RecoveryMinutes: Range{LowerBound: 1440, UpperBound: 10080},
+		},
 	}
 }
 
@@ -77,6 +219,28 @@ func NewLowDoraTeam() *DoraTeam {
 			LowerBound: 40320,  // 4 weeks
 			UpperBound: 201600, // 24 weeks
 		},
+		ChangeFailureRate: 64,
+		Review: ReviewProfile{
+			PickupMinutes:        Range{LowerBound: 1440, UpperBound: 4320},
+			ReviewMinutes:        Range{LowerBound: 60, UpperBound: 240},
+			ChangesRequestedRate: 40,
+			Reviewers:            1,
+		},
+		MergeMethods: []Weighted[PullRequestMergeMethod]{
+			{Value: PullRequestMergeMethodSquash, Weight: 1},
+		},
+		Idle: IdleProfile{
+			AbandonRate:         20,
+			AbandonAfterMinutes: Range{LowerBound: 10080, UpperBound: 40320},
+			IdleRate:            30,
+			IdleMinutes:         Range{LowerBound: 10080, UpperBound: 20160},
+		},
+		Deploy: DeployProfile{
+			QueuedSeconds:   Range{LowerBound: 60, UpperBound: 300},
+			DeployMinutes:   Range{LowerBound: 20, UpperBound: 60},
+			Pipeline:        []EnvironmentStage{{Name: "dev"}},
+			RecoveryMinutes: Range{LowerBound: 43200, UpperBound: 259200},
+		},
 	}
 }
 
@@ -87,7 +251,7 @@ func NewLowDoraTeam() *DoraTeam {
 //
 // Returns -1 if we should skip this deployment
 func (d *DoraTeam) MinutesUntilNextDeployment(ctx context.Context, ghrc *GitHubRepoContext) (int, error) {
-	recentDeployments, err := ghrc.GetLastDeployment(ctx)
+	recentDeployments, err := ghrc.GetLastDeployment(ctx, ghrc.cadenceFilter)
 
 	if err != nil {
 		return 0, fmt.Errorf("Error getting latest deployments for %s/%s: %s", ghrc.org, ghrc.name, err)
@@ -95,7 +259,13 @@ func (d *DoraTeam) MinutesUntilNextDeployment(ctx context.Context, ghrc *GitHubR
 
 	lastDeploy := time.Unix(0, 0)
 	if recentDeployments != nil {
-		lastDeploy = recentDeployments.CreatedAt
+		lastDeploy = recentDeployments.DeployedAt
+		ghrc.metrics.SetLastDeploy(lastDeploy)
+		ghrc.status.SetLastDeploy(StatusDeployment{
+			Time:        lastDeploy,
+			SHA:         recentDeployments.Deployment.Commit.Oid,
+			Environment: recentDeployments.Deployment.Environment,
+		})
 	}
 
 	// If the last deployment was less than the lower bound of the DORA team's
@@ -128,3 +298,39 @@ func (d *DoraTeam) MinutesUntilNextDeployment(ctx context.Context, ghrc *GitHubR
 
 	return minutesUntilNextDeploy, nil
 }
+
+// Returns the outcome the next change is intended to have based on the team's
+// change failure rate.
+func (d *DoraTeam) SampleIntendedOutcome() string {
+	//nolint:gosec // No security issue, just need a psudo-random outcome
+	if rand.Float64()*100 < d.ChangeFailureRate {
+		return IntendedOutcomeFailure
+	}
+	return IntendedOutcomeSuccess
+}
+
+// Returns the merge method for the next pull request, weighted by the team's
+// merge methods. Defaults to a squash merge.
+func (d *DoraTeam) SampleMergeMethod() PullRequestMergeMethod {
+	method := PickWeighted(d.MergeMethods)
+	if method == "" {
+		return PullRequestMergeMethodSquash
+	}
+	return method
+}
+
+// Decides what happens to the next pull request: whether it is abandoned, sits
+// idle before it is worked on, or goes straight through to merge. Returns how
+// long to wait before closing or picking up the pull request.
+func (p IdleProfile) SampleFate() (string, time.Duration) {
+	//nolint:gosec // No security issue, just need a psudo-random outcome
+	roll := rand.Float64() * 100
+	switch {
+	case roll < p.AbandonRate:
+		return PullRequestFateAbandon, time.Duration(p.AbandonAfterMinutes.Sample()) * time.Minute
+	case roll < p.AbandonRate+p.IdleRate:
+		return PullRequestFateIdle, time.Duration(p.IdleMinutes.Sample()) * time.Minute
+	default:
+		return PullRequestFateMerge, 0
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRange(t *testing.T) {
+	r, err := ParseRange("5-60")
+	if err != nil {
+		t.Fatalf("Error parsing range: %s", err)
+	}
+	if r.LowerBound != 5 || r.UpperBound != 60 {
+		t.Errorf("Expected 5-60, got %d-%d", r.LowerBound, r.UpperBound)
+	}
+
+	r, err = ParseRange("10")
+	if err != nil || r.LowerBound != 10 || r.UpperBound != 10 {
+		t.Errorf("Expected a single number to be an exact range, got %v %s", r, err)
+	}
+
+	for _, invalid := range []string{"60-5", "a-b", "-5"} {
+		if _, err := ParseRange(invalid); err == nil {
+			t.Errorf("Expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestSampleFate(t *testing.T) {
+	abandon := IdleProfile{AbandonRate: 100, AbandonAfterMinutes: Range{LowerBound: 5, UpperBound: 5}}
+	if fate, delay := abandon.SampleFate(); fate != PullRequestFateAbandon || delay != 5*time.Minute {
+		t.Errorf("Expected abandon after 5m, got %s after %s", fate, delay)
+	}
+
+	idle := IdleProfile{IdleRate: 100, IdleMinutes: Range{LowerBound: 60, UpperBound: 60}}
+	if fate, delay := idle.SampleFate(); fate != PullRequestFateIdle || delay != time.Hour {
+		t.Errorf("Expected idle for 1h, got %s for %s", fate, delay)
+	}
+
+	if fate, _ := (IdleProfile{}).SampleFate(); fate != PullRequestFateMerge {
+		t.Errorf("Expected merge without abandonment or idling, got %s", fate)
+	}
+}
@@ -191,6 +191,8 @@ type GitHubActionCheckRun struct {
 	Status CheckStatusState `json:"status"`
 	// The URL from which to find full details of the check run on the integrator's site.
 	DetailsUrl string `json:"detailsUrl"`
+	// The check suite that this run is a part of.
+	CheckSuite GitHubActionCheckSuite `json:"checkSuite"`
 }
 
 // GetTypename returns GitHubActionCheckRun.Typename, and is useful for accessing the field via an interface.
@@ -208,17 +210,185 @@ func (v *GitHubActionCheckRun) GetStatus() CheckStatusState { return v.Status }
 // GetDetailsUrl returns GitHubActionCheckRun.DetailsUrl, and is useful for accessing the field via an interface.
 func (v *GitHubActionCheckRun) GetDetailsUrl() string { return v.DetailsUrl }
 
+// GetCheckSuite returns GitHubActionCheckRun.CheckSuite, and is useful for accessing the field via an interface.
+func (v *GitHubActionCheckRun) GetCheckSuite() GitHubActionCheckSuite { return v.CheckSuite }
+
+// GitHubActionCheckSuite includes the requested fields of the GraphQL type CheckSuite.
+// The GraphQL type's documentation follows.
+//
+// A check suite.
+type GitHubActionCheckSuite struct {
+	// The workflow run associated with this check suite.
+	WorkflowRun GitHubActionCheckSuiteWorkflowRun `json:"workflowRun"`
+}
+
+// GetWorkflowRun returns GitHubActionCheckSuite.WorkflowRun, and is useful for accessing the field via an interface.
+func (v *GitHubActionCheckSuite) GetWorkflowRun() GitHubActionCheckSuiteWorkflowRun {
+	return v.WorkflowRun
+}
+
+// GitHubActionCheckSuiteWorkflowRun includes the requested fields of the GraphQL type WorkflowRun.
+// The GraphQL type's documentation follows.
+//
+// A workflow run.
+type GitHubActionCheckSuiteWorkflowRun struct {
+	// The workflow executed in this workflow run.
+	Workflow GitHubActionCheckSuiteWorkflowRunWorkflow `json:"workflow"`
+}
+
+// GetWorkflow returns GitHubActionCheckSuiteWorkflowRun.Workflow, and is useful for accessing the field via an interface.
+func (v *GitHubActionCheckSuiteWorkflowRun) GetWorkflow() GitHubActionCheckSuiteWorkflowRunWorkflow {
+	return v.Workflow
+}
+
+// GitHubActionCheckSuiteWorkflowRunWorkflow includes the requested fields of the GraphQL type Workflow.
+// The GraphQL type's documentation follows.
+//
+// A workflow contains meta information about an Actions workflow file.
+type GitHubActionCheckSuiteWorkflowRunWorkflow struct {
+	// The name of the workflow.
+	Name string `json:"name"`
+}
+
+// GetName returns GitHubActionCheckSuiteWorkflowRunWorkflow.Name, and is useful for accessing the field via an interface.
+func (v *GitHubActionCheckSuiteWorkflowRunWorkflow) GetName() string { return v.Name }
+
 // GitHubActionStatusContext includes the requested fields of the GraphQL type StatusContext.
 // The GraphQL type's documentation follows.
 //
 // Represents an individual commit status context
 type GitHubActionStatusContext struct {
 	Typename string `json:"__typename"`
+	// The name of this status context.
+	Context string `json:"context"`
+	// The state of this status context.
+	State StatusState `json:"state"`
+	// The URL for this status context.
+	TargetUrl string `json:"targetUrl"`
 }
 
 // GetTypename returns GitHubActionStatusContext.Typename, and is useful for accessing the field via an interface.
 func (v *GitHubActionStatusContext) GetTypename() string { return v.Typename }
 
+// GetContext returns GitHubActionStatusContext.Context, and is useful for accessing the field via an interface.
+func (v *GitHubActionStatusContext) GetContext() string { return v.Context }
+
+// GetState returns GitHubActionStatusContext.State, and is useful for accessing the field via an interface.
+func (v *GitHubActionStatusContext) GetState() StatusState { return v.State }
+
+// GetTargetUrl returns GitHubActionStatusContext.TargetUrl, and is useful for accessing the field via an interface.
+func (v *GitHubActionStatusContext) GetTargetUrl() string { return v.TargetUrl }
+
+// Detailed status information about a pull request merge.
+type MergeStateStatus string
+
+const (
+	// The head ref is out of date.
+	MergeStateStatusBehind MergeStateStatus = "BEHIND"
+	// The merge is blocked.
+	MergeStateStatusBlocked MergeStateStatus = "BLOCKED"
+	// Mergeable and passing commit status.
+	MergeStateStatusClean MergeStateStatus = "CLEAN"
+	// The merge commit cannot be cleanly created.
+	MergeStateStatusDirty MergeStateStatus = "DIRTY"
+	// The merge is blocked due to the pull request being a draft.
+	MergeStateStatusDraft MergeStateStatus = "DRAFT"
+	// Mergeable with passing commit status and pre-receive hooks.
+	MergeStateStatusHasHooks MergeStateStatus = "HAS_HOOKS"
+	// The state cannot currently be determined.
+	MergeStateStatusUnknown MergeStateStatus = "UNKNOWN"
+	// Mergeable with non-passing commit status.
+	MergeStateStatusUnstable MergeStateStatus = "UNSTABLE"
+)
+
+// Whether or not a PullRequest can be merged.
+type MergeableState string
+
+const (
+	// The pull request cannot be merged due to merge conflicts.
+	MergeableStateConflicting MergeableState = "CONFLICTING"
+	// The pull request can be merged.
+	MergeableStateMergeable MergeableState = "MERGEABLE"
+	// The mergeability of the pull request is still being calculated.
+	MergeableStateUnknown MergeableState = "UNKNOWN"
+)
+
+// The possible methods for updating a pull request's head branch with the base branch.
+type PullRequestBranchUpdateMethod string
+
+const (
+	// Update branch via merge
+	PullRequestBranchUpdateMethodMerge PullRequestBranchUpdateMethod = "MERGE"
+	// Update branch via rebase
+	PullRequestBranchUpdateMethodRebase PullRequestBranchUpdateMethod = "REBASE"
+)
+
+// Represents available types of methods to use when merging a pull request.
+type PullRequestMergeMethod string
+
+const (
+	// Add all commits from the head branch to the base branch with a merge commit.
+	PullRequestMergeMethodMerge PullRequestMergeMethod = "MERGE"
+	// Add all commits from the head branch onto the base branch individually.
+	PullRequestMergeMethodRebase PullRequestMergeMethod = "REBASE"
+	// Combine all commits from the head branch into a single commit in the base branch.
+	PullRequestMergeMethodSquash PullRequestMergeMethod = "SQUASH"
+)
+
+// The possible events to perform on a pull request review.
+type PullRequestReviewEvent string
+
+const (
+	// Submit feedback and approve merging these changes.
+	PullRequestReviewEventApprove PullRequestReviewEvent = "APPROVE"
+	// Submit general feedback without explicit approval.
+	PullRequestReviewEventComment PullRequestReviewEvent = "COMMENT"
+	// Dismiss review so it now longer effects merging.
+	PullRequestReviewEventDismiss PullRequestReviewEvent = "DISMISS"
+	// Submit feedback that must be addressed before merging.
+	PullRequestReviewEventRequestChanges PullRequestReviewEvent = "REQUEST_CHANGES"
+)
+
+// The possible states of a pull request review.
+type PullRequestReviewState string
+
+const (
+	// A review allowing the pull request to merge.
+	PullRequestReviewStateApproved PullRequestReviewState = "APPROVED"
+	// A review blocking the pull request from merging.
+	PullRequestReviewStateChangesRequested PullRequestReviewState = "CHANGES_REQUESTED"
+	// An informational review.
+	PullRequestReviewStateCommented PullRequestReviewState = "COMMENTED"
+	// A review that has been dismissed.
+	PullRequestReviewStateDismissed PullRequestReviewState = "DISMISSED"
+	// A review that has not yet been submitted.
+	PullRequestReviewStatePending PullRequestReviewState = "PENDING"
+)
+
+// The possible states of a pull request.
+type PullRequestState string
+
+const (
+	// A pull request that has been closed without being merged.
+	PullRequestStateClosed PullRequestState = "CLOSED"
+	// A pull request that has been closed by being merged.
+	PullRequestStateMerged PullRequestState = "MERGED"
+	// A pull request that is still open.
+	PullRequestStateOpen PullRequestState = "OPEN"
+)
+
+// The repository's visibility level.
+type RepositoryVisibility string
+
+const (
+	// The repository is visible only to users in the same business.
+	RepositoryVisibilityInternal RepositoryVisibility = "INTERNAL"
+	// The repository is visible only to those with explicit access.
+	RepositoryVisibilityPrivate RepositoryVisibility = "PRIVATE"
+	// The repository is visible to everyone.
+	RepositoryVisibilityPublic RepositoryVisibility = "PUBLIC"
+)
+
 // The possible commit status states.
 type StatusState string
 
@@ -235,6 +405,112 @@ const (
 	StatusStateSuccess StatusState = "SUCCESS"
 )
 
+// __addPullRequestReviewInput is used internally by genqlient
+type __addPullRequestReviewInput struct {
+	PullRequestId string                 `json:"pullRequestId"`
+	Event         PullRequestReviewEvent `json:"event"`
+	Body          string                 `json:"body"`
+}
+
+// GetPullRequestId returns __addPullRequestReviewInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetEvent returns __addPullRequestReviewInput.Event, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetEvent() PullRequestReviewEvent { return v.Event }
+
+// GetBody returns __addPullRequestReviewInput.Body, and is useful for accessing the field via an interface.
+func (v *__addPullRequestReviewInput) GetBody() string { return v.Body }
+
+// __closePullRequestInput is used internally by genqlient
+type __closePullRequestInput struct {
+	PullRequestId string `json:"pullRequestId"`
+}
+
+// GetPullRequestId returns __closePullRequestInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__closePullRequestInput) GetPullRequestId() string { return v.PullRequestId }
+
+// __createCommitOnBranchInput is used internally by genqlient
+type __createCommitOnBranchInput struct {
+	RepositoryNameWithOwner string `json:"repositoryNameWithOwner"`
+	BranchName              string `json:"branchName"`
+	ExpectedHeadOid         string `json:"expectedHeadOid"`
+	Headline                string `json:"headline"`
+	Body                    string `json:"body"`
+	Path                    string `json:"path"`
+	Contents                string `json:"contents"`
+}
+
+// GetRepositoryNameWithOwner returns __createCommitOnBranchInput.RepositoryNameWithOwner, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetRepositoryNameWithOwner() string {
+	return v.RepositoryNameWithOwner
+}
+
+// GetBranchName returns __createCommitOnBranchInput.BranchName, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetBranchName() string { return v.BranchName }
+
+// GetExpectedHeadOid returns __createCommitOnBranchInput.ExpectedHeadOid, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetExpectedHeadOid() string { return v.ExpectedHeadOid }
+
+// GetHeadline returns __createCommitOnBranchInput.Headline, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetHeadline() string { return v.Headline }
+
+// GetBody returns __createCommitOnBranchInput.Body, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetBody() string { return v.Body }
+
+// GetPath returns __createCommitOnBranchInput.Path, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetPath() string { return v.Path }
+
+// GetContents returns __createCommitOnBranchInput.Contents, and is useful for accessing the field via an interface.
+func (v *__createCommitOnBranchInput) GetContents() string { return v.Contents }
+
+// __createDeploymentInput is used internally by genqlient
+type __createDeploymentInput struct {
+	RepositoryId string `json:"repositoryId"`
+	RefId        string `json:"refId"`
+	Environment  string `json:"environment"`
+	Description  string `json:"description"`
+}
+
+// GetRepositoryId returns __createDeploymentInput.RepositoryId, and is useful for accessing the field via an interface.
+func (v *__createDeploymentInput) GetRepositoryId() string { return v.RepositoryId }
+
+// GetRefId returns __createDeploymentInput.RefId, and is useful for accessing the field via an interface.
+func (v *__createDeploymentInput) GetRefId() string { return v.RefId }
+
+// GetEnvironment returns __createDeploymentInput.Environment, and is useful for accessing the field via an interface.
+func (v *__createDeploymentInput) GetEnvironment() string { return v.Environment }
+
+// GetDescription returns __createDeploymentInput.Description, and is useful for accessing the field via an interface.
+func (v *__createDeploymentInput) GetDescription() string { return v.Description }
+
+// __createDeploymentStatusInput is used internally by genqlient
+type __createDeploymentStatusInput struct {
+	DeploymentId string                `json:"deploymentId"`
+	State        DeploymentStatusState `json:"state"`
+	Description  string                `json:"description"`
+}
+
+// GetDeploymentId returns __createDeploymentStatusInput.DeploymentId, and is useful for accessing the field via an interface.
+func (v *__createDeploymentStatusInput) GetDeploymentId() string { return v.DeploymentId }
+
+// GetState returns __createDeploymentStatusInput.State, and is useful for accessing the field via an interface.
+func (v *__createDeploymentStatusInput) GetState() DeploymentStatusState { return v.State }
+
+// GetDescription returns __createDeploymentStatusInput.Description, and is useful for accessing the field via an interface.
+func (v *__createDeploymentStatusInput) GetDescription() string { return v.Description }
+
+// __createEnvironmentInput is used internally by genqlient
+type __createEnvironmentInput struct {
+	RepositoryId string `json:"repositoryId"`
+	Name         string `json:"name"`
+}
+
+// GetRepositoryId returns __createEnvironmentInput.RepositoryId, and is useful for accessing the field via an interface.
+func (v *__createEnvironmentInput) GetRepositoryId() string { return v.RepositoryId }
+
+// GetName returns __createEnvironmentInput.Name, and is useful for accessing the field via an interface.
+func (v *__createEnvironmentInput) GetName() string { return v.Name }
+
 // __createIssueInput is used internally by genqlient
 type __createIssueInput struct {
 	Body         string `json:"Body"`
@@ -275,11 +551,78 @@ func (v *__createPullRequestInput) GetRepositoryId() string { return v.Repositor
 // GetTitle returns __createPullRequestInput.Title, and is useful for accessing the field via an interface.
 func (v *__createPullRequestInput) GetTitle() string { return v.Title }
 
+// __createRefInput is used internally by genqlient
+type __createRefInput struct {
+	RepositoryId string `json:"repositoryId"`
+	Name         string `json:"name"`
+	Oid          string `json:"oid"`
+}
+
+// GetRepositoryId returns __createRefInput.RepositoryId, and is useful for accessing the field via an interface.
+func (v *__createRefInput) GetRepositoryId() string { return v.RepositoryId }
+
+// GetName returns __createRefInput.Name, and is useful for accessing the field via an interface.
+func (v *__createRefInput) GetName() string { return v.Name }
+
+// GetOid returns __createRefInput.Oid, and is useful for accessing the field via an interface.
+func (v *__createRefInput) GetOid() string { return v.Oid }
+
+// __createRepositoryInput is used internally by genqlient
+type __createRepositoryInput struct {
+	OwnerId     string               `json:"ownerId"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Visibility  RepositoryVisibility `json:"visibility"`
+}
+
+// GetOwnerId returns __createRepositoryInput.OwnerId, and is useful for accessing the field via an interface.
+func (v *__createRepositoryInput) GetOwnerId() string { return v.OwnerId }
+
+// GetName returns __createRepositoryInput.Name, and is useful for accessing the field via an interface.
+func (v *__createRepositoryInput) GetName() string { return v.Name }
+
+// GetDescription returns __createRepositoryInput.Description, and is useful for accessing the field via an interface.
+func (v *__createRepositoryInput) GetDescription() string { return v.Description }
+
+// GetVisibility returns __createRepositoryInput.Visibility, and is useful for accessing the field via an interface.
+func (v *__createRepositoryInput) GetVisibility() RepositoryVisibility { return v.Visibility }
+
+// __deleteRefInput is used internally by genqlient
+type __deleteRefInput struct {
+	RefId string `json:"refId"`
+}
+
+// GetRefId returns __deleteRefInput.RefId, and is useful for accessing the field via an interface.
+func (v *__deleteRefInput) GetRefId() string { return v.RefId }
+
+// __enablePullRequestAutoMergeInput is used internally by genqlient
+type __enablePullRequestAutoMergeInput struct {
+	PullRequestId string                 `json:"pullRequestId"`
+	MergeMethod   PullRequestMergeMethod `json:"mergeMethod"`
+}
+
+// GetPullRequestId returns __enablePullRequestAutoMergeInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__enablePullRequestAutoMergeInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetMergeMethod returns __enablePullRequestAutoMergeInput.MergeMethod, and is useful for accessing the field via an interface.
+func (v *__enablePullRequestAutoMergeInput) GetMergeMethod() PullRequestMergeMethod {
+	return v.MergeMethod
+}
+
+// __enqueuePullRequestInput is used internally by genqlient
+type __enqueuePullRequestInput struct {
+	PullRequestId string `json:"pullRequestId"`
+}
+
+// GetPullRequestId returns __enqueuePullRequestInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__enqueuePullRequestInput) GetPullRequestId() string { return v.PullRequestId }
+
 // __getCommitGitHubActionsRunsInput is used internally by genqlient
 type __getCommitGitHubActionsRunsInput struct {
 	Owner     string `json:"owner"`
 	Repo      string `json:"repo"`
 	CommitSha string `json:"commitSha"`
+	Cursor    string `json:"cursor,omitempty"`
 }
 
 // GetOwner returns __getCommitGitHubActionsRunsInput.Owner, and is useful for accessing the field via an interface.
@@ -291,18 +634,141 @@ func (v *__getCommitGitHubActionsRunsInput) GetRepo() string { return v.Repo }
 // GetCommitSha returns __getCommitGitHubActionsRunsInput.CommitSha, and is useful for accessing the field via an interface.
 func (v *__getCommitGitHubActionsRunsInput) GetCommitSha() string { return v.CommitSha }
 
-// __getLatestDeploymentsInput is used internally by genqlient
-type __getLatestDeploymentsInput struct {
+// GetCursor returns __getCommitGitHubActionsRunsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getCommitGitHubActionsRunsInput) GetCursor() string { return v.Cursor }
+
+// __getCommitParentInput is used internally by genqlient
+type __getCommitParentInput struct {
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+	Oid   string `json:"oid"`
+}
+
+// GetOwner returns __getCommitParentInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getCommitParentInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getCommitParentInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getCommitParentInput) GetRepo() string { return v.Repo }
+
+// GetOid returns __getCommitParentInput.Oid, and is useful for accessing the field via an interface.
+func (v *__getCommitParentInput) GetOid() string { return v.Oid }
+
+// __getDefaultBranchInput is used internally by genqlient
+type __getDefaultBranchInput struct {
 	Owner string `json:"owner"`
 	Repo  string `json:"repo"`
 }
 
+// GetOwner returns __getDefaultBranchInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getDefaultBranchInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getDefaultBranchInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getDefaultBranchInput) GetRepo() string { return v.Repo }
+
+// __getGeneratedBranchesInput is used internally by genqlient
+type __getGeneratedBranchesInput struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Prefix string `json:"prefix"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// GetOwner returns __getGeneratedBranchesInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getGeneratedBranchesInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getGeneratedBranchesInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getGeneratedBranchesInput) GetRepo() string { return v.Repo }
+
+// GetPrefix returns __getGeneratedBranchesInput.Prefix, and is useful for accessing the field via an interface.
+func (v *__getGeneratedBranchesInput) GetPrefix() string { return v.Prefix }
+
+// GetCursor returns __getGeneratedBranchesInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getGeneratedBranchesInput) GetCursor() string { return v.Cursor }
+
+// __getLatestDeploymentsInput is used internally by genqlient
+type __getLatestDeploymentsInput struct {
+	Owner        string   `json:"owner"`
+	Repo         string   `json:"repo"`
+	Environments []string `json:"environments,omitempty"`
+	Cursor       string   `json:"cursor,omitempty"`
+}
+
 // GetOwner returns __getLatestDeploymentsInput.Owner, and is useful for accessing the field via an interface.
 func (v *__getLatestDeploymentsInput) GetOwner() string { return v.Owner }
 
 // GetRepo returns __getLatestDeploymentsInput.Repo, and is useful for accessing the field via an interface.
 func (v *__getLatestDeploymentsInput) GetRepo() string { return v.Repo }
 
+// GetEnvironments returns __getLatestDeploymentsInput.Environments, and is useful for accessing the field via an interface.
+func (v *__getLatestDeploymentsInput) GetEnvironments() []string { return v.Environments }
+
+// GetCursor returns __getLatestDeploymentsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getLatestDeploymentsInput) GetCursor() string { return v.Cursor }
+
+// __getOpenPullRequestsInput is used internally by genqlient
+type __getOpenPullRequestsInput struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// GetOwner returns __getOpenPullRequestsInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getOpenPullRequestsInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getOpenPullRequestsInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getOpenPullRequestsInput) GetRepo() string { return v.Repo }
+
+// GetCursor returns __getOpenPullRequestsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__getOpenPullRequestsInput) GetCursor() string { return v.Cursor }
+
+// __getPullRequestHeadInput is used internally by genqlient
+type __getPullRequestHeadInput struct {
+	Owner    string `json:"owner"`
+	Repo     string `json:"repo"`
+	PrNumber int    `json:"prNumber"`
+}
+
+// GetOwner returns __getPullRequestHeadInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestHeadInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getPullRequestHeadInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getPullRequestHeadInput) GetRepo() string { return v.Repo }
+
+// GetPrNumber returns __getPullRequestHeadInput.PrNumber, and is useful for accessing the field via an interface.
+func (v *__getPullRequestHeadInput) GetPrNumber() int { return v.PrNumber }
+
+// __getPullRequestMergeStateInput is used internally by genqlient
+type __getPullRequestMergeStateInput struct {
+	Owner    string `json:"owner"`
+	Repo     string `json:"repo"`
+	PrNumber int    `json:"prNumber"`
+}
+
+// GetOwner returns __getPullRequestMergeStateInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeStateInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getPullRequestMergeStateInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeStateInput) GetRepo() string { return v.Repo }
+
+// GetPrNumber returns __getPullRequestMergeStateInput.PrNumber, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeStateInput) GetPrNumber() int { return v.PrNumber }
+
+// __getPullRequestMergeabilityInput is used internally by genqlient
+type __getPullRequestMergeabilityInput struct {
+	Owner    string `json:"owner"`
+	Repo     string `json:"repo"`
+	PrNumber int    `json:"prNumber"`
+}
+
+// GetOwner returns __getPullRequestMergeabilityInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeabilityInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getPullRequestMergeabilityInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeabilityInput) GetRepo() string { return v.Repo }
+
+// GetPrNumber returns __getPullRequestMergeabilityInput.PrNumber, and is useful for accessing the field via an interface.
+func (v *__getPullRequestMergeabilityInput) GetPrNumber() int { return v.PrNumber }
+
 // __getPullRequestStatusCheckRollupInput is used internally by genqlient
 type __getPullRequestStatusCheckRollupInput struct {
 	Owner    string `json:"owner"`
@@ -331,6 +797,34 @@ func (v *__getRepoIdInput) GetOwner() string { return v.Owner }
 // GetName returns __getRepoIdInput.Name, and is useful for accessing the field via an interface.
 func (v *__getRepoIdInput) GetName() string { return v.Name }
 
+// __getRepositoryFileInput is used internally by genqlient
+type __getRepositoryFileInput struct {
+	Owner      string `json:"owner"`
+	Repo       string `json:"repo"`
+	Expression string `json:"expression"`
+}
+
+// GetOwner returns __getRepositoryFileInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryFileInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getRepositoryFileInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getRepositoryFileInput) GetRepo() string { return v.Repo }
+
+// GetExpression returns __getRepositoryFileInput.Expression, and is useful for accessing the field via an interface.
+func (v *__getRepositoryFileInput) GetExpression() string { return v.Expression }
+
+// __getRepositoryOwnerInput is used internally by genqlient
+type __getRepositoryOwnerInput struct {
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+}
+
+// GetOwner returns __getRepositoryOwnerInput.Owner, and is useful for accessing the field via an interface.
+func (v *__getRepositoryOwnerInput) GetOwner() string { return v.Owner }
+
+// GetRepo returns __getRepositoryOwnerInput.Repo, and is useful for accessing the field via an interface.
+func (v *__getRepositoryOwnerInput) GetRepo() string { return v.Repo }
+
 // __getUserInput is used internally by genqlient
 type __getUserInput struct {
 	Login string `json:"Login"`
@@ -341,801 +835,4549 @@ func (v *__getUserInput) GetLogin() string { return v.Login }
 
 // __mergePullRequestInput is used internally by genqlient
 type __mergePullRequestInput struct {
-	PullRequestId string `json:"pullRequestId"`
+	PullRequestId string                 `json:"pullRequestId"`
+	MergeMethod   PullRequestMergeMethod `json:"mergeMethod"`
 }
 
 // GetPullRequestId returns __mergePullRequestInput.PullRequestId, and is useful for accessing the field via an interface.
 func (v *__mergePullRequestInput) GetPullRequestId() string { return v.PullRequestId }
 
-// createIssueCreateIssueCreateIssuePayload includes the requested fields of the GraphQL type CreateIssuePayload.
+// GetMergeMethod returns __mergePullRequestInput.MergeMethod, and is useful for accessing the field via an interface.
+func (v *__mergePullRequestInput) GetMergeMethod() PullRequestMergeMethod { return v.MergeMethod }
+
+// __requestReviewsInput is used internally by genqlient
+type __requestReviewsInput struct {
+	PullRequestId string   `json:"pullRequestId"`
+	UserIds       []string `json:"userIds"`
+}
+
+// GetPullRequestId returns __requestReviewsInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__requestReviewsInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetUserIds returns __requestReviewsInput.UserIds, and is useful for accessing the field via an interface.
+func (v *__requestReviewsInput) GetUserIds() []string { return v.UserIds }
+
+// __updatePullRequestBodyInput is used internally by genqlient
+type __updatePullRequestBodyInput struct {
+	PullRequestId string `json:"pullRequestId"`
+	Body          string `json:"body"`
+}
+
+// GetPullRequestId returns __updatePullRequestBodyInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__updatePullRequestBodyInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetBody returns __updatePullRequestBodyInput.Body, and is useful for accessing the field via an interface.
+func (v *__updatePullRequestBodyInput) GetBody() string { return v.Body }
+
+// __updatePullRequestBranchInput is used internally by genqlient
+type __updatePullRequestBranchInput struct {
+	PullRequestId   string                        `json:"pullRequestId"`
+	ExpectedHeadOid string                        `json:"expectedHeadOid"`
+	UpdateMethod    PullRequestBranchUpdateMethod `json:"updateMethod"`
+}
+
+// GetPullRequestId returns __updatePullRequestBranchInput.PullRequestId, and is useful for accessing the field via an interface.
+func (v *__updatePullRequestBranchInput) GetPullRequestId() string { return v.PullRequestId }
+
+// GetExpectedHeadOid returns __updatePullRequestBranchInput.ExpectedHeadOid, and is useful for accessing the field via an interface.
+func (v *__updatePullRequestBranchInput) GetExpectedHeadOid() string { return v.ExpectedHeadOid }
+
+// GetUpdateMethod returns __updatePullRequestBranchInput.UpdateMethod, and is useful for accessing the field via an interface.
+func (v *__updatePullRequestBranchInput) GetUpdateMethod() PullRequestBranchUpdateMethod {
+	return v.UpdateMethod
+}
+
+// __updateRefInput is used internally by genqlient
+type __updateRefInput struct {
+	RefId string `json:"refId"`
+	Oid   string `json:"oid"`
+	Force bool   `json:"force"`
+}
+
+// GetRefId returns __updateRefInput.RefId, and is useful for accessing the field via an interface.
+func (v *__updateRefInput) GetRefId() string { return v.RefId }
+
+// GetOid returns __updateRefInput.Oid, and is useful for accessing the field via an interface.
+func (v *__updateRefInput) GetOid() string { return v.Oid }
+
+// GetForce returns __updateRefInput.Force, and is useful for accessing the field via an interface.
+func (v *__updateRefInput) GetForce() bool { return v.Force }
+
+// addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload includes the requested fields of the GraphQL type AddPullRequestReviewPayload.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of CreateIssue
-type createIssueCreateIssueCreateIssuePayload struct {
-	// The new issue.
-	Issue createIssueCreateIssueCreateIssuePayloadIssue `json:"issue"`
+// Autogenerated return type of AddPullRequestReview
+type addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload struct {
+	// The newly created pull request review.
+	PullRequestReview addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview `json:"pullRequestReview"`
 }
 
-// GetIssue returns createIssueCreateIssueCreateIssuePayload.Issue, and is useful for accessing the field via an interface.
-func (v *createIssueCreateIssueCreateIssuePayload) GetIssue() createIssueCreateIssueCreateIssuePayloadIssue {
-	return v.Issue
+// GetPullRequestReview returns addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload.PullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload) GetPullRequestReview() addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview {
+	return v.PullRequestReview
 }
 
-// createIssueCreateIssueCreateIssuePayloadIssue includes the requested fields of the GraphQL type Issue.
+// addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
 // The GraphQL type's documentation follows.
 //
-// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
-type createIssueCreateIssueCreateIssuePayloadIssue struct {
-	// The Node ID of the Issue object
+// A review object for a given pull request.
+type addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview struct {
+	// The Node ID of the PullRequestReview object
 	Id string `json:"id"`
+	// Identifies the current state of the pull request review.
+	State PullRequestReviewState `json:"state"`
+	// Identifies when the Pull Request Review was submitted
+	SubmittedAt time.Time `json:"submittedAt"`
 }
 
-// GetId returns createIssueCreateIssueCreateIssuePayloadIssue.Id, and is useful for accessing the field via an interface.
-func (v *createIssueCreateIssueCreateIssuePayloadIssue) GetId() string { return v.Id }
+// GetId returns addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview.Id, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview) GetId() string {
+	return v.Id
+}
 
-// createIssueResponse is returned by createIssue on success.
-type createIssueResponse struct {
-	// Creates a new issue.
-	CreateIssue createIssueCreateIssueCreateIssuePayload `json:"createIssue"`
+// GetState returns addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview.State, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview) GetState() PullRequestReviewState {
+	return v.State
 }
 
-// GetCreateIssue returns createIssueResponse.CreateIssue, and is useful for accessing the field via an interface.
-func (v *createIssueResponse) GetCreateIssue() createIssueCreateIssueCreateIssuePayload {
-	return v.CreateIssue
+// GetSubmittedAt returns addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview.SubmittedAt, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayloadPullRequestReview) GetSubmittedAt() time.Time {
+	return v.SubmittedAt
 }
 
-// createPullRequestCreatePullRequestCreatePullRequestPayload includes the requested fields of the GraphQL type CreatePullRequestPayload.
+// addPullRequestReviewResponse is returned by addPullRequestReview on success.
+type addPullRequestReviewResponse struct {
+	// Adds a review to a Pull Request.
+	AddPullRequestReview addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload `json:"addPullRequestReview"`
+}
+
+// GetAddPullRequestReview returns addPullRequestReviewResponse.AddPullRequestReview, and is useful for accessing the field via an interface.
+func (v *addPullRequestReviewResponse) GetAddPullRequestReview() addPullRequestReviewAddPullRequestReviewAddPullRequestReviewPayload {
+	return v.AddPullRequestReview
+}
+
+// closePullRequestClosePullRequestClosePullRequestPayload includes the requested fields of the GraphQL type ClosePullRequestPayload.
 // The GraphQL type's documentation follows.
 //
-// Autogenerated return type of CreatePullRequest
-type createPullRequestCreatePullRequestCreatePullRequestPayload struct {
-	// The new pull request.
-	PullRequest createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest `json:"pullRequest"`
+// Autogenerated return type of ClosePullRequest
+type closePullRequestClosePullRequestClosePullRequestPayload struct {
+	// The pull request that was closed.
+	PullRequest closePullRequestClosePullRequestClosePullRequestPayloadPullRequest `json:"pullRequest"`
 }
 
-// GetPullRequest returns createPullRequestCreatePullRequestCreatePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
-func (v *createPullRequestCreatePullRequestCreatePullRequestPayload) GetPullRequest() createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest {
+// GetPullRequest returns closePullRequestClosePullRequestClosePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *closePullRequestClosePullRequestClosePullRequestPayload) GetPullRequest() closePullRequestClosePullRequestClosePullRequestPayloadPullRequest {
 	return v.PullRequest
 }
 
-// createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// closePullRequestClosePullRequestClosePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
 // The GraphQL type's documentation follows.
 //
 // A repository pull request.
-type createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest struct {
+type closePullRequestClosePullRequestClosePullRequestPayloadPullRequest struct {
 	// The Node ID of the PullRequest object
 	Id string `json:"id"`
-	// Identifies the pull request number.
-	Number int `json:"number"`
+	// Identifies the state of the pull request.
+	State PullRequestState `json:"state"`
 }
 
-// GetId returns createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest.Id, and is useful for accessing the field via an interface.
-func (v *createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest) GetId() string {
+// GetId returns closePullRequestClosePullRequestClosePullRequestPayloadPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *closePullRequestClosePullRequestClosePullRequestPayloadPullRequest) GetId() string {
 	return v.Id
 }
 
-// GetNumber returns createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest.Number, and is useful for accessing the field via an interface.
-func (v *createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest) GetNumber() int {
-	return v.Number
+// GetState returns closePullRequestClosePullRequestClosePullRequestPayloadPullRequest.State, and is useful for accessing the field via an interface.
+func (v *closePullRequestClosePullRequestClosePullRequestPayloadPullRequest) GetState() PullRequestState {
+	return v.State
 }
 
-// createPullRequestResponse is returned by createPullRequest on success.
-type createPullRequestResponse struct {
-	// Create a new pull request
-	CreatePullRequest createPullRequestCreatePullRequestCreatePullRequestPayload `json:"createPullRequest"`
+// closePullRequestResponse is returned by closePullRequest on success.
+type closePullRequestResponse struct {
+	// Close a pull request.
+	ClosePullRequest closePullRequestClosePullRequestClosePullRequestPayload `json:"closePullRequest"`
 }
 
-// GetCreatePullRequest returns createPullRequestResponse.CreatePullRequest, and is useful for accessing the field via an interface.
-func (v *createPullRequestResponse) GetCreatePullRequest() createPullRequestCreatePullRequestCreatePullRequestPayload {
-	return v.CreatePullRequest
+// GetClosePullRequest returns closePullRequestResponse.ClosePullRequest, and is useful for accessing the field via an interface.
+func (v *closePullRequestResponse) GetClosePullRequest() closePullRequestClosePullRequestClosePullRequestPayload {
+	return v.ClosePullRequest
 }
 
-// getCommitGitHubActionsRunsRepository includes the requested fields of the GraphQL type Repository.
+// createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload includes the requested fields of the GraphQL type CreateCommitOnBranchPayload.
 // The GraphQL type's documentation follows.
 //
-// A repository contains the content for a project.
-type getCommitGitHubActionsRunsRepository struct {
-	// A Git object in the repository
-	Object getCommitGitHubActionsRunsRepositoryObjectGitObject `json:"-"`
+// Autogenerated return type of CreateCommitOnBranch
+type createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload struct {
+	// The new commit.
+	Commit createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit `json:"commit"`
 }
 
-// GetObject returns getCommitGitHubActionsRunsRepository.Object, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepository) GetObject() getCommitGitHubActionsRunsRepositoryObjectGitObject {
-	return v.Object
+// GetCommit returns createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload.Commit, and is useful for accessing the field via an interface.
+func (v *createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload) GetCommit() createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit {
+	return v.Commit
 }
 
-func (v *getCommitGitHubActionsRunsRepository) UnmarshalJSON(b []byte) error {
+// createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+	// The HTTP URL for this commit
+	Url string `json:"url"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetOid returns createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit.Oid, and is useful for accessing the field via an interface.
+func (v *createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit) GetOid() string {
+	return v.Oid
+}
 
-	var firstPass struct {
-		*getCommitGitHubActionsRunsRepository
-		Object json.RawMessage `json:"object"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getCommitGitHubActionsRunsRepository = v
+// GetUrl returns createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit.Url, and is useful for accessing the field via an interface.
+func (v *createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayloadCommit) GetUrl() string {
+	return v.Url
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// createCommitOnBranchResponse is returned by createCommitOnBranch on success.
+type createCommitOnBranchResponse struct {
+	// Appends a commit to the given branch as the authenticated user.
+	//
+	// This mutation creates a commit whose parent is the HEAD of the provided
+	// branch and also updates that branch to point to the new commit.
+	// It can be thought of as similar to `git commit`.
+	//
+	// ### Locating a Branch
+	//
+	// Commits are appended to a `branch` of type `Ref`.
+	// This must refer to a git branch (i.e.  the fully qualified path must
+	// begin with `refs/heads/`, although including this prefix is optional.
+	//
+	// Callers may specify the `branch` to commit to either by its global node
+	// ID or by passing both of `repositoryNameWithOwner` and `refName`.  For
+	// more details see the documentation for `CommittableBranch`.
+	//
+	// ### Describing Changes
+	//
+	// `fileChanges` are specified as a `FilesChanges` object describing
+	// `FileAdditions` and `FileDeletions`.
+	//
+	// Please see the documentation for `FileChanges` for more information on
+	// how to use this argument to describe any set of file changes.
+	//
+	// ### Authorship
+	//
+	// Similar to the web commit interface, this mutation does not support
+	// specifying the author or committer of the commit and will not add
+	// support for this in the future.
+	//
+	// A commit created by a successful execution of this mutation will be
+	// authored by the owner of the credential which authenticates the API
+	// request.  The committer will be identical to that of commits authored
+	// using the web interface.
+	//
+	// If you need full control over author and committer information, please
+	// use the Git Database REST API instead.
+	//
+	// ### Commit Signing
+	//
+	// Commits made using this mutation are automatically signed by GitHub if
+	// supported and will be marked as verified in the user interface.
+	CreateCommitOnBranch createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload `json:"createCommitOnBranch"`
+}
 
-	{
-		dst := &v.Object
-		src := firstPass.Object
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal getCommitGitHubActionsRunsRepository.Object: %w", err)
-			}
-		}
-	}
-	return nil
+// GetCreateCommitOnBranch returns createCommitOnBranchResponse.CreateCommitOnBranch, and is useful for accessing the field via an interface.
+func (v *createCommitOnBranchResponse) GetCreateCommitOnBranch() createCommitOnBranchCreateCommitOnBranchCreateCommitOnBranchPayload {
+	return v.CreateCommitOnBranch
 }
 
-type __premarshalgetCommitGitHubActionsRunsRepository struct {
-	Object json.RawMessage `json:"object"`
+// createDeploymentCreateDeploymentCreateDeploymentPayload includes the requested fields of the GraphQL type CreateDeploymentPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateDeployment
+type createDeploymentCreateDeploymentCreateDeploymentPayload struct {
+	// The new deployment.
+	Deployment createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment `json:"deployment"`
 }
 
-func (v *getCommitGitHubActionsRunsRepository) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetDeployment returns createDeploymentCreateDeploymentCreateDeploymentPayload.Deployment, and is useful for accessing the field via an interface.
+func (v *createDeploymentCreateDeploymentCreateDeploymentPayload) GetDeployment() createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment {
+	return v.Deployment
 }
 
-func (v *getCommitGitHubActionsRunsRepository) __premarshalJSON() (*__premarshalgetCommitGitHubActionsRunsRepository, error) {
-	var retval __premarshalgetCommitGitHubActionsRunsRepository
+// createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment struct {
+	// The Node ID of the Deployment object
+	Id string `json:"id"`
+	// Identifies the oid of the deployment commit, even if the commit has been deleted.
+	CommitOid string `json:"commitOid"`
+}
 
-	{
+// GetId returns createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment.Id, and is useful for accessing the field via an interface.
+func (v *createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment) GetId() string {
+	return v.Id
+}
 
-		dst := &retval.Object
-		src := v.Object
-		var err error
-		*dst, err = __marshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal getCommitGitHubActionsRunsRepository.Object: %w", err)
-		}
-	}
-	return &retval, nil
+// GetCommitOid returns createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment.CommitOid, and is useful for accessing the field via an interface.
+func (v *createDeploymentCreateDeploymentCreateDeploymentPayloadDeployment) GetCommitOid() string {
+	return v.CommitOid
 }
 
-// getCommitGitHubActionsRunsRepositoryObjectBlob includes the requested fields of the GraphQL type Blob.
+// createDeploymentResponse is returned by createDeployment on success.
+type createDeploymentResponse struct {
+	// Creates a new deployment event.
+	CreateDeployment createDeploymentCreateDeploymentCreateDeploymentPayload `json:"createDeployment"`
+}
+
+// GetCreateDeployment returns createDeploymentResponse.CreateDeployment, and is useful for accessing the field via an interface.
+func (v *createDeploymentResponse) GetCreateDeployment() createDeploymentCreateDeploymentCreateDeploymentPayload {
+	return v.CreateDeployment
+}
+
+// createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload includes the requested fields of the GraphQL type CreateDeploymentStatusPayload.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git blob.
-type getCommitGitHubActionsRunsRepositoryObjectBlob struct {
-	Typename string `json:"__typename"`
+// Autogenerated return type of CreateDeploymentStatus
+type createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload struct {
+	// The new deployment status.
+	DeploymentStatus createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus `json:"deploymentStatus"`
 }
 
-// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectBlob.Typename, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectBlob) GetTypename() string { return v.Typename }
+// GetDeploymentStatus returns createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload.DeploymentStatus, and is useful for accessing the field via an interface.
+func (v *createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload) GetDeploymentStatus() createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus {
+	return v.DeploymentStatus
+}
 
-// getCommitGitHubActionsRunsRepositoryObjectCommit includes the requested fields of the GraphQL type Commit.
+// createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git commit.
-type getCommitGitHubActionsRunsRepositoryObjectCommit struct {
-	Typename string `json:"__typename"`
-	// Check and Status rollup information for this commit.
-	StatusCheckRollup getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup `json:"statusCheckRollup"`
+// Describes the status of a given deployment attempt.
+type createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus struct {
+	// The Node ID of the DeploymentStatus object
+	Id string `json:"id"`
+	// Identifies the current state of the deployment.
+	State DeploymentStatusState `json:"state"`
 }
 
-// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectCommit.Typename, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) GetTypename() string { return v.Typename }
+// GetId returns createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus.Id, and is useful for accessing the field via an interface.
+func (v *createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus) GetId() string {
+	return v.Id
+}
 
-// GetStatusCheckRollup returns getCommitGitHubActionsRunsRepositoryObjectCommit.StatusCheckRollup, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) GetStatusCheckRollup() getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup {
-	return v.StatusCheckRollup
+// GetState returns createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus.State, and is useful for accessing the field via an interface.
+func (v *createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayloadDeploymentStatus) GetState() DeploymentStatusState {
+	return v.State
 }
 
-// getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// createDeploymentStatusResponse is returned by createDeploymentStatus on success.
+type createDeploymentStatusResponse struct {
+	// Create a deployment status.
+	CreateDeploymentStatus createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload `json:"createDeploymentStatus"`
+}
+
+// GetCreateDeploymentStatus returns createDeploymentStatusResponse.CreateDeploymentStatus, and is useful for accessing the field via an interface.
+func (v *createDeploymentStatusResponse) GetCreateDeploymentStatus() createDeploymentStatusCreateDeploymentStatusCreateDeploymentStatusPayload {
+	return v.CreateDeploymentStatus
+}
+
+// createEnvironmentCreateEnvironmentCreateEnvironmentPayload includes the requested fields of the GraphQL type CreateEnvironmentPayload.
 // The GraphQL type's documentation follows.
 //
-// Represents the rollup for both the check runs and status for a commit.
-type getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup struct {
-	// A list of status contexts and check runs for this commit.
-	Contexts getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection `json:"contexts"`
+// Autogenerated return type of CreateEnvironment
+type createEnvironmentCreateEnvironmentCreateEnvironmentPayload struct {
+	// The new or existing environment.
+	Environment createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment `json:"environment"`
 }
 
-// GetContexts returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup.Contexts, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup) GetContexts() getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection {
-	return v.Contexts
+// GetEnvironment returns createEnvironmentCreateEnvironmentCreateEnvironmentPayload.Environment, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentCreateEnvironmentPayload) GetEnvironment() createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment {
+	return v.Environment
 }
 
-// getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection includes the requested fields of the GraphQL type StatusCheckRollupContextConnection.
+// createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment includes the requested fields of the GraphQL type Environment.
 // The GraphQL type's documentation follows.
 //
-// The connection type for StatusCheckRollupContext.
-type getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection struct {
-	// A list of nodes.
-	Nodes []GitHubAction `json:"-"`
+// An environment.
+type createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment struct {
+	// The Node ID of the Environment object
+	Id string `json:"id"`
+	// The name of the environment
+	Name string `json:"name"`
 }
 
-// GetNodes returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) GetNodes() []GitHubAction {
-	return v.Nodes
+// GetId returns createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment) GetId() string {
+	return v.Id
 }
 
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
+// GetName returns createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *createEnvironmentCreateEnvironmentCreateEnvironmentPayloadEnvironment) GetName() string {
+	return v.Name
+}
 
-	var firstPass struct {
-		*getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection
-		Nodes []json.RawMessage `json:"nodes"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection = v
+// createEnvironmentResponse is returned by createEnvironment on success.
+type createEnvironmentResponse struct {
+	// Creates an environment or simply returns it if already exists.
+	CreateEnvironment createEnvironmentCreateEnvironmentCreateEnvironmentPayload `json:"createEnvironment"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetCreateEnvironment returns createEnvironmentResponse.CreateEnvironment, and is useful for accessing the field via an interface.
+func (v *createEnvironmentResponse) GetCreateEnvironment() createEnvironmentCreateEnvironmentCreateEnvironmentPayload {
+	return v.CreateEnvironment
+}
 
-	{
-		dst := &v.Nodes
-		src := firstPass.Nodes
-		*dst = make(
-			[]GitHubAction,
-			len(src))
-		for i, src := range src {
-			dst := &(*dst)[i]
-			if len(src) != 0 && string(src) != "null" {
-				err = __unmarshalGitHubAction(
-					src, dst)
-				if err != nil {
-					return fmt.Errorf(
-						"unable to unmarshal getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes: %w", err)
-				}
-			}
-		}
-	}
-	return nil
+// createIssueCreateIssueCreateIssuePayload includes the requested fields of the GraphQL type CreateIssuePayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of CreateIssue
+type createIssueCreateIssueCreateIssuePayload struct {
+	// The new issue.
+	Issue createIssueCreateIssueCreateIssuePayloadIssue `json:"issue"`
 }
 
-type __premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection struct {
-	Nodes []json.RawMessage `json:"nodes"`
+// GetIssue returns createIssueCreateIssueCreateIssuePayload.Issue, and is useful for accessing the field via an interface.
+func (v *createIssueCreateIssueCreateIssuePayload) GetIssue() createIssueCreateIssueCreateIssuePayloadIssue {
+	return v.Issue
 }
 
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// createIssueCreateIssueCreateIssuePayloadIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An Issue is a place to discuss ideas, enhancements, tasks, and bugs for a project.
+type createIssueCreateIssueCreateIssuePayloadIssue struct {
+	// The Node ID of the Issue object
+	Id string `json:"id"`
 }
 
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) __premarshalJSON() (*__premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection, error) {
-	var retval __premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection
+// GetId returns createIssueCreateIssueCreateIssuePayloadIssue.Id, and is useful for accessing the field via an interface.
+func (v *createIssueCreateIssueCreateIssuePayloadIssue) GetId() string { return v.Id }
 
-	{
+// createIssueResponse is returned by createIssue on success.
+type createIssueResponse struct {
+	// Creates a new issue.
+	CreateIssue createIssueCreateIssueCreateIssuePayload `json:"createIssue"`
+}
 
-		dst := &retval.Nodes
-		src := v.Nodes
-		*dst = make(
-			[]json.RawMessage,
-			len(src))
-		for i, src := range src {
-			dst := &(*dst)[i]
-			var err error
-			*dst, err = __marshalGitHubAction(
-				&src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes: %w", err)
-			}
-		}
-	}
-	return &retval, nil
+// GetCreateIssue returns createIssueResponse.CreateIssue, and is useful for accessing the field via an interface.
+func (v *createIssueResponse) GetCreateIssue() createIssueCreateIssueCreateIssuePayload {
+	return v.CreateIssue
 }
 
-// getCommitGitHubActionsRunsRepositoryObjectGitObject includes the requested fields of the GraphQL interface GitObject.
-//
-// getCommitGitHubActionsRunsRepositoryObjectGitObject is implemented by the following types:
-// getCommitGitHubActionsRunsRepositoryObjectBlob
-// getCommitGitHubActionsRunsRepositoryObjectCommit
-// getCommitGitHubActionsRunsRepositoryObjectTag
-// getCommitGitHubActionsRunsRepositoryObjectTree
+// createPullRequestCreatePullRequestCreatePullRequestPayload includes the requested fields of the GraphQL type CreatePullRequestPayload.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git object.
-type getCommitGitHubActionsRunsRepositoryObjectGitObject interface {
-	implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+// Autogenerated return type of CreatePullRequest
+type createPullRequestCreatePullRequestCreatePullRequestPayload struct {
+	// The new pull request.
+	PullRequest createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest `json:"pullRequest"`
 }
 
-func (v *getCommitGitHubActionsRunsRepositoryObjectBlob) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
-}
-func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+// GetPullRequest returns createPullRequestCreatePullRequestCreatePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *createPullRequestCreatePullRequestCreatePullRequestPayload) GetPullRequest() createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest {
+	return v.PullRequest
 }
-func (v *getCommitGitHubActionsRunsRepositoryObjectTag) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+
+// createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+	// Identifies the pull request number.
+	Number int `json:"number"`
 }
-func (v *getCommitGitHubActionsRunsRepositoryObjectTree) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+
+// GetId returns createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest) GetId() string {
+	return v.Id
 }
 
-func __unmarshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(b []byte, v *getCommitGitHubActionsRunsRepositoryObjectGitObject) error {
-	if string(b) == "null" {
-		return nil
-	}
-
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
-
-	switch tn.TypeName {
-	case "Blob":
-		*v = new(getCommitGitHubActionsRunsRepositoryObjectBlob)
-		return json.Unmarshal(b, *v)
-	case "Commit":
-		*v = new(getCommitGitHubActionsRunsRepositoryObjectCommit)
-		return json.Unmarshal(b, *v)
-	case "Tag":
-		*v = new(getCommitGitHubActionsRunsRepositoryObjectTag)
-		return json.Unmarshal(b, *v)
-	case "Tree":
-		*v = new(getCommitGitHubActionsRunsRepositoryObjectTree)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing GitObject.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for getCommitGitHubActionsRunsRepositoryObjectGitObject: "%v"`, tn.TypeName)
-	}
+// GetNumber returns createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest.Number, and is useful for accessing the field via an interface.
+func (v *createPullRequestCreatePullRequestCreatePullRequestPayloadPullRequest) GetNumber() int {
+	return v.Number
 }
 
-func __marshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(v *getCommitGitHubActionsRunsRepositoryObjectGitObject) ([]byte, error) {
-
-	var typename string
-	switch v := (*v).(type) {
-	case *getCommitGitHubActionsRunsRepositoryObjectBlob:
-		typename = "Blob"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*getCommitGitHubActionsRunsRepositoryObjectBlob
-		}{typename, v}
-		return json.Marshal(result)
-	case *getCommitGitHubActionsRunsRepositoryObjectCommit:
-		typename = "Commit"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*getCommitGitHubActionsRunsRepositoryObjectCommit
-		}{typename, v}
-		return json.Marshal(result)
-	case *getCommitGitHubActionsRunsRepositoryObjectTag:
-		typename = "Tag"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*getCommitGitHubActionsRunsRepositoryObjectTag
-		}{typename, v}
-		return json.Marshal(result)
-	case *getCommitGitHubActionsRunsRepositoryObjectTree:
-		typename = "Tree"
+// createPullRequestResponse is returned by createPullRequest on success.
+type createPullRequestResponse struct {
+	// Create a new pull request
+	CreatePullRequest createPullRequestCreatePullRequestCreatePullRequestPayload `json:"createPullRequest"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*getCommitGitHubActionsRunsRepositoryObjectTree
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for getCommitGitHubActionsRunsRepositoryObjectGitObject: "%T"`, v)
-	}
+// GetCreatePullRequest returns createPullRequestResponse.CreatePullRequest, and is useful for accessing the field via an interface.
+func (v *createPullRequestResponse) GetCreatePullRequest() createPullRequestCreatePullRequestCreatePullRequestPayload {
+	return v.CreatePullRequest
 }
 
-// getCommitGitHubActionsRunsRepositoryObjectTag includes the requested fields of the GraphQL type Tag.
+// createRefCreateRefCreateRefPayload includes the requested fields of the GraphQL type CreateRefPayload.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git tag.
-type getCommitGitHubActionsRunsRepositoryObjectTag struct {
-	Typename string `json:"__typename"`
+// Autogenerated return type of CreateRef
+type createRefCreateRefCreateRefPayload struct {
+	// The newly created ref.
+	Ref createRefCreateRefCreateRefPayloadRef `json:"ref"`
 }
 
-// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectTag.Typename, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectTag) GetTypename() string { return v.Typename }
+// GetRef returns createRefCreateRefCreateRefPayload.Ref, and is useful for accessing the field via an interface.
+func (v *createRefCreateRefCreateRefPayload) GetRef() createRefCreateRefCreateRefPayloadRef {
+	return v.Ref
+}
 
-// getCommitGitHubActionsRunsRepositoryObjectTree includes the requested fields of the GraphQL type Tree.
+// createRefCreateRefCreateRefPayloadRef includes the requested fields of the GraphQL type Ref.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git tree.
-type getCommitGitHubActionsRunsRepositoryObjectTree struct {
-	Typename string `json:"__typename"`
+// Represents a Git reference.
+type createRefCreateRefCreateRefPayloadRef struct {
+	// The Node ID of the Ref object
+	Id string `json:"id"`
+	// The ref name.
+	Name string `json:"name"`
 }
 
-// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectTree.Typename, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsRepositoryObjectTree) GetTypename() string { return v.Typename }
-
-// getCommitGitHubActionsRunsResponse is returned by getCommitGitHubActionsRuns on success.
-type getCommitGitHubActionsRunsResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository getCommitGitHubActionsRunsRepository `json:"repository"`
-}
+// GetId returns createRefCreateRefCreateRefPayloadRef.Id, and is useful for accessing the field via an interface.
+func (v *createRefCreateRefCreateRefPayloadRef) GetId() string { return v.Id }
 
-// GetRepository returns getCommitGitHubActionsRunsResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getCommitGitHubActionsRunsResponse) GetRepository() getCommitGitHubActionsRunsRepository {
-	return v.Repository
-}
+// GetName returns createRefCreateRefCreateRefPayloadRef.Name, and is useful for accessing the field via an interface.
+func (v *createRefCreateRefCreateRefPayloadRef) GetName() string { return v.Name }
 
-// getLatestDeploymentsRepository includes the requested fields of the GraphQL type Repository.
-// The GraphQL type's documentation follows.
-//
-// A repository contains the content for a project.
-type getLatestDeploymentsRepository struct {
-	// Deployments associated with the repository
-	Deployments getLatestDeploymentsRepositoryDeploymentsDeploymentConnection `json:"deployments"`
+// createRefResponse is returned by createRef on success.
+type createRefResponse struct {
+	// Create a new Git Ref.
+	CreateRef createRefCreateRefCreateRefPayload `json:"createRef"`
 }
 
-// GetDeployments returns getLatestDeploymentsRepository.Deployments, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepository) GetDeployments() getLatestDeploymentsRepositoryDeploymentsDeploymentConnection {
-	return v.Deployments
-}
+// GetCreateRef returns createRefResponse.CreateRef, and is useful for accessing the field via an interface.
+func (v *createRefResponse) GetCreateRef() createRefCreateRefCreateRefPayload { return v.CreateRef }
 
-// getLatestDeploymentsRepositoryDeploymentsDeploymentConnection includes the requested fields of the GraphQL type DeploymentConnection.
+// createRepositoryCreateRepositoryCreateRepositoryPayload includes the requested fields of the GraphQL type CreateRepositoryPayload.
 // The GraphQL type's documentation follows.
 //
-// The connection type for Deployment.
-type getLatestDeploymentsRepositoryDeploymentsDeploymentConnection struct {
-	// A list of nodes.
-	Nodes []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment `json:"nodes"`
+// Autogenerated return type of CreateRepository
+type createRepositoryCreateRepositoryCreateRepositoryPayload struct {
+	// The new repository.
+	Repository createRepositoryCreateRepositoryCreateRepositoryPayloadRepository `json:"repository"`
 }
 
-// GetNodes returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnection) GetNodes() []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment {
-	return v.Nodes
+// GetRepository returns createRepositoryCreateRepositoryCreateRepositoryPayload.Repository, and is useful for accessing the field via an interface.
+func (v *createRepositoryCreateRepositoryCreateRepositoryPayload) GetRepository() createRepositoryCreateRepositoryCreateRepositoryPayloadRepository {
+	return v.Repository
 }
 
-// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment includes the requested fields of the GraphQL type Deployment.
+// createRepositoryCreateRepositoryCreateRepositoryPayloadRepository includes the requested fields of the GraphQL type Repository.
 // The GraphQL type's documentation follows.
 //
-// Represents triggered deployment instance.
-type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment struct {
-	// Identifies the date and time when the object was created.
-	CreatedAt time.Time `json:"createdAt"`
-	// Identifies the commit sha of the deployment.
-	Commit getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit `json:"commit"`
-	// The deployment description.
-	Description string `json:"description"`
-	// The current state of the deployment.
-	State DeploymentState `json:"state"`
-	// A list of statuses associated with the deployment.
-	Statuses getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection `json:"statuses"`
-}
-
-// GetCreatedAt returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.CreatedAt, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// A repository contains the content for a project.
+type createRepositoryCreateRepositoryCreateRepositoryPayloadRepository struct {
+	// The Node ID of the Repository object
+	Id string `json:"id"`
+	// The HTTP URL for this repository
+	Url string `json:"url"`
 }
 
-// GetCommit returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Commit, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetCommit() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit {
-	return v.Commit
+// GetId returns createRepositoryCreateRepositoryCreateRepositoryPayloadRepository.Id, and is useful for accessing the field via an interface.
+func (v *createRepositoryCreateRepositoryCreateRepositoryPayloadRepository) GetId() string {
+	return v.Id
 }
 
-// GetDescription returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Description, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetDescription() string {
-	return v.Description
+// GetUrl returns createRepositoryCreateRepositoryCreateRepositoryPayloadRepository.Url, and is useful for accessing the field via an interface.
+func (v *createRepositoryCreateRepositoryCreateRepositoryPayloadRepository) GetUrl() string {
+	return v.Url
 }
 
-// GetState returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.State, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetState() DeploymentState {
-	return v.State
+// createRepositoryResponse is returned by createRepository on success.
+type createRepositoryResponse struct {
+	// Create a new repository.
+	CreateRepository createRepositoryCreateRepositoryCreateRepositoryPayload `json:"createRepository"`
 }
 
-// GetStatuses returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Statuses, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetStatuses() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection {
-	return v.Statuses
+// GetCreateRepository returns createRepositoryResponse.CreateRepository, and is useful for accessing the field via an interface.
+func (v *createRepositoryResponse) GetCreateRepository() createRepositoryCreateRepositoryCreateRepositoryPayload {
+	return v.CreateRepository
 }
 
-// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit includes the requested fields of the GraphQL type Commit.
+// deleteRefDeleteRefDeleteRefPayload includes the requested fields of the GraphQL type DeleteRefPayload.
 // The GraphQL type's documentation follows.
 //
-// Represents a Git commit.
-type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit struct {
-	// The Git commit message
-	Message string `json:"message"`
+// Autogenerated return type of DeleteRef
+type deleteRefDeleteRefDeleteRefPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationId string `json:"clientMutationId"`
 }
 
-// GetMessage returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit.Message, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit) GetMessage() string {
-	return v.Message
-}
+// GetClientMutationId returns deleteRefDeleteRefDeleteRefPayload.ClientMutationId, and is useful for accessing the field via an interface.
+func (v *deleteRefDeleteRefDeleteRefPayload) GetClientMutationId() string { return v.ClientMutationId }
 
-// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection includes the requested fields of the GraphQL type DeploymentStatusConnection.
-// The GraphQL type's documentation follows.
-//
-// The connection type for DeploymentStatus.
-type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection struct {
-	// A list of nodes.
-	Nodes []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus `json:"nodes"`
+// deleteRefResponse is returned by deleteRef on success.
+type deleteRefResponse struct {
+	// Delete a Git Ref.
+	DeleteRef deleteRefDeleteRefDeleteRefPayload `json:"deleteRef"`
 }
 
-// GetNodes returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection) GetNodes() []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus {
-	return v.Nodes
-}
+// GetDeleteRef returns deleteRefResponse.DeleteRef, and is useful for accessing the field via an interface.
+func (v *deleteRefResponse) GetDeleteRef() deleteRefDeleteRefDeleteRefPayload { return v.DeleteRef }
 
-// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload includes the requested fields of the GraphQL type EnablePullRequestAutoMergePayload.
 // The GraphQL type's documentation follows.
 //
-// Describes the status of a given deployment attempt.
-type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus struct {
-	// Identifies the date and time when the object was last updated.
-	UpdatedAt time.Time `json:"updatedAt"`
-	// Identifies the current state of the deployment.
-	State DeploymentStatusState `json:"state"`
-	// Identifies the environment of the deployment at the time of this deployment status
-	Environment string `json:"environment"`
+// Autogenerated return type of EnablePullRequestAutoMerge
+type enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload struct {
+	// The pull request auto-merge was enabled on.
+	PullRequest enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest `json:"pullRequest"`
 }
 
-// GetUpdatedAt returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetPullRequest returns enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload) GetPullRequest() enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest {
+	return v.PullRequest
 }
 
-// GetState returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.State, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetState() DeploymentStatusState {
-	return v.State
+// enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
 }
 
-// GetEnvironment returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.Environment, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetEnvironment() string {
-	return v.Environment
+// GetId returns enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayloadPullRequest) GetId() string {
+	return v.Id
 }
 
-// getLatestDeploymentsResponse is returned by getLatestDeployments on success.
-type getLatestDeploymentsResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository getLatestDeploymentsRepository `json:"repository"`
+// enablePullRequestAutoMergeResponse is returned by enablePullRequestAutoMerge on success.
+type enablePullRequestAutoMergeResponse struct {
+	// Enable the default auto-merge on a pull request.
+	EnablePullRequestAutoMerge enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload `json:"enablePullRequestAutoMerge"`
 }
 
-// GetRepository returns getLatestDeploymentsResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getLatestDeploymentsResponse) GetRepository() getLatestDeploymentsRepository {
-	return v.Repository
+// GetEnablePullRequestAutoMerge returns enablePullRequestAutoMergeResponse.EnablePullRequestAutoMerge, and is useful for accessing the field via an interface.
+func (v *enablePullRequestAutoMergeResponse) GetEnablePullRequestAutoMerge() enablePullRequestAutoMergeEnablePullRequestAutoMergeEnablePullRequestAutoMergePayload {
+	return v.EnablePullRequestAutoMerge
 }
 
-// getPullRequestStatusCheckRollupRepository includes the requested fields of the GraphQL type Repository.
+// enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload includes the requested fields of the GraphQL type EnqueuePullRequestPayload.
 // The GraphQL type's documentation follows.
 //
-// A repository contains the content for a project.
-type getPullRequestStatusCheckRollupRepository struct {
-	// Returns a single pull request from the current repository by number.
-	PullRequest getPullRequestStatusCheckRollupRepositoryPullRequest `json:"pullRequest"`
+// Autogenerated return type of EnqueuePullRequest
+type enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload struct {
+	// The merge queue entry for the enqueued pull request.
+	MergeQueueEntry enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry `json:"mergeQueueEntry"`
 }
 
-// GetPullRequest returns getPullRequestStatusCheckRollupRepository.PullRequest, and is useful for accessing the field via an interface.
-func (v *getPullRequestStatusCheckRollupRepository) GetPullRequest() getPullRequestStatusCheckRollupRepositoryPullRequest {
-	return v.PullRequest
+// GetMergeQueueEntry returns enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload.MergeQueueEntry, and is useful for accessing the field via an interface.
+func (v *enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload) GetMergeQueueEntry() enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry {
+	return v.MergeQueueEntry
 }
 
-// getPullRequestStatusCheckRollupRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry includes the requested fields of the GraphQL type MergeQueueEntry.
 // The GraphQL type's documentation follows.
 //
-// A repository pull request.
-type getPullRequestStatusCheckRollupRepositoryPullRequest struct {
-	// Check and Status rollup information for the PR's head ref.
-	StatusCheckRollup getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup `json:"statusCheckRollup"`
-}
-
-// GetStatusCheckRollup returns getPullRequestStatusCheckRollupRepositoryPullRequest.StatusCheckRollup, and is useful for accessing the field via an interface.
-func (v *getPullRequestStatusCheckRollupRepositoryPullRequest) GetStatusCheckRollup() getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup {
-	return v.StatusCheckRollup
+// Entries in a MergeQueue
+type enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry struct {
+	// The Node ID of the MergeQueueEntry object
+	Id string `json:"id"`
+	// The position of this entry in the queue
+	Position int `json:"position"`
 }
 
-// getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
-// The GraphQL type's documentation follows.
-//
-// Represents the rollup for both the check runs and status for a commit.
-type getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup struct {
-	// The combined status for the commit.
-	State StatusState `json:"state"`
+// GetId returns enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry.Id, and is useful for accessing the field via an interface.
+func (v *enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry) GetId() string {
+	return v.Id
 }
 
-// GetState returns getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup.State, and is useful for accessing the field via an interface.
-func (v *getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup) GetState() StatusState {
-	return v.State
+// GetPosition returns enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry.Position, and is useful for accessing the field via an interface.
+func (v *enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayloadMergeQueueEntry) GetPosition() int {
+	return v.Position
 }
 
-// getPullRequestStatusCheckRollupResponse is returned by getPullRequestStatusCheckRollup on success.
-type getPullRequestStatusCheckRollupResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository getPullRequestStatusCheckRollupRepository `json:"repository"`
+// enqueuePullRequestResponse is returned by enqueuePullRequest on success.
+type enqueuePullRequestResponse struct {
+	// Add a pull request to the merge queue.
+	EnqueuePullRequest enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload `json:"enqueuePullRequest"`
 }
 
-// GetRepository returns getPullRequestStatusCheckRollupResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getPullRequestStatusCheckRollupResponse) GetRepository() getPullRequestStatusCheckRollupRepository {
-	return v.Repository
+// GetEnqueuePullRequest returns enqueuePullRequestResponse.EnqueuePullRequest, and is useful for accessing the field via an interface.
+func (v *enqueuePullRequestResponse) GetEnqueuePullRequest() enqueuePullRequestEnqueuePullRequestEnqueuePullRequestPayload {
+	return v.EnqueuePullRequest
 }
 
-// getRepoIdRepository includes the requested fields of the GraphQL type Repository.
+// getCommitGitHubActionsRunsRepository includes the requested fields of the GraphQL type Repository.
 // The GraphQL type's documentation follows.
 //
 // A repository contains the content for a project.
-type getRepoIdRepository struct {
-	// The Node ID of the Repository object
-	Id string `json:"id"`
+type getCommitGitHubActionsRunsRepository struct {
+	// A Git object in the repository
+	Object getCommitGitHubActionsRunsRepositoryObjectGitObject `json:"-"`
 }
 
-// GetId returns getRepoIdRepository.Id, and is useful for accessing the field via an interface.
-func (v *getRepoIdRepository) GetId() string { return v.Id }
+// GetObject returns getCommitGitHubActionsRunsRepository.Object, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepository) GetObject() getCommitGitHubActionsRunsRepositoryObjectGitObject {
+	return v.Object
+}
 
-// getRepoIdResponse is returned by getRepoId on success.
-type getRepoIdResponse struct {
-	// Lookup a given repository by the owner and repository name.
-	Repository getRepoIdRepository `json:"repository"`
+func (v *getCommitGitHubActionsRunsRepository) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCommitGitHubActionsRunsRepository
+		Object json.RawMessage `json:"object"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCommitGitHubActionsRunsRepository = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Object
+		src := firstPass.Object
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getCommitGitHubActionsRunsRepository.Object: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetRepository returns getRepoIdResponse.Repository, and is useful for accessing the field via an interface.
-func (v *getRepoIdResponse) GetRepository() getRepoIdRepository { return v.Repository }
+type __premarshalgetCommitGitHubActionsRunsRepository struct {
+	Object json.RawMessage `json:"object"`
+}
 
-// getUserResponse is returned by getUser on success.
-type getUserResponse struct {
-	// Lookup a user by login.
-	User getUserUser `json:"user"`
+func (v *getCommitGitHubActionsRunsRepository) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetUser returns getUserResponse.User, and is useful for accessing the field via an interface.
-func (v *getUserResponse) GetUser() getUserUser { return v.User }
+func (v *getCommitGitHubActionsRunsRepository) __premarshalJSON() (*__premarshalgetCommitGitHubActionsRunsRepository, error) {
+	var retval __premarshalgetCommitGitHubActionsRunsRepository
 
-// getUserUser includes the requested fields of the GraphQL type User.
+	{
+
+		dst := &retval.Object
+		src := v.Object
+		var err error
+		*dst, err = __marshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getCommitGitHubActionsRunsRepository.Object: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectBlob includes the requested fields of the GraphQL type Blob.
 // The GraphQL type's documentation follows.
 //
-// A user is an individual's account on GitHub that owns repositories and can make new content.
-type getUserUser struct {
-	// The user's public profile name.
-	TheirName string `json:"theirName"`
-	// Identifies the date and time when the object was created.
-	CreatedAt time.Time `json:"createdAt"`
+// Represents a Git blob.
+type getCommitGitHubActionsRunsRepositoryObjectBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectBlob) GetTypename() string { return v.Typename }
+
+// getCommitGitHubActionsRunsRepositoryObjectCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getCommitGitHubActionsRunsRepositoryObjectCommit struct {
+	Typename string `json:"__typename"`
+	// Check and Status rollup information for this commit.
+	StatusCheckRollup getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup `json:"statusCheckRollup"`
+}
+
+// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) GetTypename() string { return v.Typename }
+
+// GetStatusCheckRollup returns getCommitGitHubActionsRunsRepositoryObjectCommit.StatusCheckRollup, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) GetStatusCheckRollup() getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup {
+	return v.StatusCheckRollup
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup struct {
+	// A list of status contexts and check runs for this commit.
+	Contexts getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection `json:"contexts"`
+}
+
+// GetContexts returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup.Contexts, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollup) GetContexts() getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection {
+	return v.Contexts
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection includes the requested fields of the GraphQL type StatusCheckRollupContextConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for StatusCheckRollupContext.
+type getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection struct {
+	// Information to aid in pagination.
+	PageInfo getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []GitHubAction `json:"-"`
+}
+
+// GetPageInfo returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) GetPageInfo() getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) GetNodes() []GitHubAction {
+	return v.Nodes
+}
+
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]GitHubAction,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalGitHubAction(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection struct {
+	PageInfo getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo `json:"pageInfo"`
+
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection) __premarshalJSON() (*__premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection, error) {
+	var retval __premarshalgetCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection
+
+	retval.PageInfo = v.PageInfo
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalGitHubAction(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommitStatusCheckRollupContextsStatusCheckRollupContextConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getCommitGitHubActionsRunsRepositoryObjectGitObject is implemented by the following types:
+// getCommitGitHubActionsRunsRepositoryObjectBlob
+// getCommitGitHubActionsRunsRepositoryObjectCommit
+// getCommitGitHubActionsRunsRepositoryObjectTag
+// getCommitGitHubActionsRunsRepositoryObjectTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getCommitGitHubActionsRunsRepositoryObjectGitObject interface {
+	implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getCommitGitHubActionsRunsRepositoryObjectBlob) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+}
+func (v *getCommitGitHubActionsRunsRepositoryObjectCommit) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+}
+func (v *getCommitGitHubActionsRunsRepositoryObjectTag) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+}
+func (v *getCommitGitHubActionsRunsRepositoryObjectTree) implementsGraphQLInterfacegetCommitGitHubActionsRunsRepositoryObjectGitObject() {
+}
+
+func __unmarshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(b []byte, v *getCommitGitHubActionsRunsRepositoryObjectGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getCommitGitHubActionsRunsRepositoryObjectBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getCommitGitHubActionsRunsRepositoryObjectCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getCommitGitHubActionsRunsRepositoryObjectTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getCommitGitHubActionsRunsRepositoryObjectTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getCommitGitHubActionsRunsRepositoryObjectGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetCommitGitHubActionsRunsRepositoryObjectGitObject(v *getCommitGitHubActionsRunsRepositoryObjectGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getCommitGitHubActionsRunsRepositoryObjectBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitGitHubActionsRunsRepositoryObjectBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitGitHubActionsRunsRepositoryObjectCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitGitHubActionsRunsRepositoryObjectCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitGitHubActionsRunsRepositoryObjectTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitGitHubActionsRunsRepositoryObjectTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitGitHubActionsRunsRepositoryObjectTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitGitHubActionsRunsRepositoryObjectTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getCommitGitHubActionsRunsRepositoryObjectGitObject: "%T"`, v)
+	}
+}
+
+// getCommitGitHubActionsRunsRepositoryObjectTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getCommitGitHubActionsRunsRepositoryObjectTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectTag.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectTag) GetTypename() string { return v.Typename }
+
+// getCommitGitHubActionsRunsRepositoryObjectTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getCommitGitHubActionsRunsRepositoryObjectTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitGitHubActionsRunsRepositoryObjectTree.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsRepositoryObjectTree) GetTypename() string { return v.Typename }
+
+// getCommitGitHubActionsRunsResponse is returned by getCommitGitHubActionsRuns on success.
+type getCommitGitHubActionsRunsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getCommitGitHubActionsRunsRepository `json:"repository"`
+}
+
+// GetRepository returns getCommitGitHubActionsRunsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getCommitGitHubActionsRunsResponse) GetRepository() getCommitGitHubActionsRunsRepository {
+	return v.Repository
+}
+
+// getCommitParentRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getCommitParentRepository struct {
+	// A Git object in the repository
+	Object getCommitParentRepositoryObjectGitObject `json:"-"`
+}
+
+// GetObject returns getCommitParentRepository.Object, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepository) GetObject() getCommitParentRepositoryObjectGitObject {
+	return v.Object
+}
+
+func (v *getCommitParentRepository) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCommitParentRepository
+		Object json.RawMessage `json:"object"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCommitParentRepository = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Object
+		src := firstPass.Object
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetCommitParentRepositoryObjectGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getCommitParentRepository.Object: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetCommitParentRepository struct {
+	Object json.RawMessage `json:"object"`
+}
+
+func (v *getCommitParentRepository) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCommitParentRepository) __premarshalJSON() (*__premarshalgetCommitParentRepository, error) {
+	var retval __premarshalgetCommitParentRepository
+
+	{
+
+		dst := &retval.Object
+		src := v.Object
+		var err error
+		*dst, err = __marshalgetCommitParentRepositoryObjectGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getCommitParentRepository.Object: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getCommitParentRepositoryObjectBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getCommitParentRepositoryObjectBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitParentRepositoryObjectBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectBlob) GetTypename() string { return v.Typename }
+
+// getCommitParentRepositoryObjectCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getCommitParentRepositoryObjectCommit struct {
+	Typename string `json:"__typename"`
+	// The parents of a commit.
+	Parents getCommitParentRepositoryObjectCommitParentsCommitConnection `json:"parents"`
+}
+
+// GetTypename returns getCommitParentRepositoryObjectCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectCommit) GetTypename() string { return v.Typename }
+
+// GetParents returns getCommitParentRepositoryObjectCommit.Parents, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectCommit) GetParents() getCommitParentRepositoryObjectCommitParentsCommitConnection {
+	return v.Parents
+}
+
+// getCommitParentRepositoryObjectCommitParentsCommitConnection includes the requested fields of the GraphQL type CommitConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Commit.
+type getCommitParentRepositoryObjectCommitParentsCommitConnection struct {
+	// A list of nodes.
+	Nodes []getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit `json:"nodes"`
+}
+
+// GetNodes returns getCommitParentRepositoryObjectCommitParentsCommitConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectCommitParentsCommitConnection) GetNodes() []getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit {
+	return v.Nodes
+}
+
+// getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetOid returns getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectCommitParentsCommitConnectionNodesCommit) GetOid() string {
+	return v.Oid
+}
+
+// getCommitParentRepositoryObjectGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getCommitParentRepositoryObjectGitObject is implemented by the following types:
+// getCommitParentRepositoryObjectBlob
+// getCommitParentRepositoryObjectCommit
+// getCommitParentRepositoryObjectTag
+// getCommitParentRepositoryObjectTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getCommitParentRepositoryObjectGitObject interface {
+	implementsGraphQLInterfacegetCommitParentRepositoryObjectGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getCommitParentRepositoryObjectBlob) implementsGraphQLInterfacegetCommitParentRepositoryObjectGitObject() {
+}
+func (v *getCommitParentRepositoryObjectCommit) implementsGraphQLInterfacegetCommitParentRepositoryObjectGitObject() {
+}
+func (v *getCommitParentRepositoryObjectTag) implementsGraphQLInterfacegetCommitParentRepositoryObjectGitObject() {
+}
+func (v *getCommitParentRepositoryObjectTree) implementsGraphQLInterfacegetCommitParentRepositoryObjectGitObject() {
+}
+
+func __unmarshalgetCommitParentRepositoryObjectGitObject(b []byte, v *getCommitParentRepositoryObjectGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getCommitParentRepositoryObjectBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getCommitParentRepositoryObjectCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getCommitParentRepositoryObjectTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getCommitParentRepositoryObjectTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getCommitParentRepositoryObjectGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetCommitParentRepositoryObjectGitObject(v *getCommitParentRepositoryObjectGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getCommitParentRepositoryObjectBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitParentRepositoryObjectBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitParentRepositoryObjectCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitParentRepositoryObjectCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitParentRepositoryObjectTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitParentRepositoryObjectTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getCommitParentRepositoryObjectTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getCommitParentRepositoryObjectTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getCommitParentRepositoryObjectGitObject: "%T"`, v)
+	}
+}
+
+// getCommitParentRepositoryObjectTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getCommitParentRepositoryObjectTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitParentRepositoryObjectTag.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectTag) GetTypename() string { return v.Typename }
+
+// getCommitParentRepositoryObjectTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getCommitParentRepositoryObjectTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getCommitParentRepositoryObjectTree.Typename, and is useful for accessing the field via an interface.
+func (v *getCommitParentRepositoryObjectTree) GetTypename() string { return v.Typename }
+
+// getCommitParentResponse is returned by getCommitParent on success.
+type getCommitParentResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getCommitParentRepository `json:"repository"`
+}
+
+// GetRepository returns getCommitParentResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getCommitParentResponse) GetRepository() getCommitParentRepository { return v.Repository }
+
+// getDefaultBranchRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getDefaultBranchRepository struct {
+	// The Node ID of the Repository object
+	Id string `json:"id"`
+	// The Ref associated with the repository's default branch.
+	DefaultBranchRef getDefaultBranchRepositoryDefaultBranchRef `json:"defaultBranchRef"`
+}
+
+// GetId returns getDefaultBranchRepository.Id, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepository) GetId() string { return v.Id }
+
+// GetDefaultBranchRef returns getDefaultBranchRepository.DefaultBranchRef, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepository) GetDefaultBranchRef() getDefaultBranchRepositoryDefaultBranchRef {
+	return v.DefaultBranchRef
+}
+
+// getDefaultBranchRepositoryDefaultBranchRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getDefaultBranchRepositoryDefaultBranchRef struct {
+	// The ref name.
+	Name string `json:"name"`
+	// The object the ref points to. Returns null when object does not exist.
+	Target getDefaultBranchRepositoryDefaultBranchRefTargetGitObject `json:"-"`
+}
+
+// GetName returns getDefaultBranchRepositoryDefaultBranchRef.Name, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRef) GetName() string { return v.Name }
+
+// GetTarget returns getDefaultBranchRepositoryDefaultBranchRef.Target, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRef) GetTarget() getDefaultBranchRepositoryDefaultBranchRefTargetGitObject {
+	return v.Target
+}
+
+func (v *getDefaultBranchRepositoryDefaultBranchRef) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getDefaultBranchRepositoryDefaultBranchRef
+		Target json.RawMessage `json:"target"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getDefaultBranchRepositoryDefaultBranchRef = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Target
+		src := firstPass.Target
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetDefaultBranchRepositoryDefaultBranchRefTargetGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getDefaultBranchRepositoryDefaultBranchRef.Target: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetDefaultBranchRepositoryDefaultBranchRef struct {
+	Name string `json:"name"`
+
+	Target json.RawMessage `json:"target"`
+}
+
+func (v *getDefaultBranchRepositoryDefaultBranchRef) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getDefaultBranchRepositoryDefaultBranchRef) __premarshalJSON() (*__premarshalgetDefaultBranchRepositoryDefaultBranchRef, error) {
+	var retval __premarshalgetDefaultBranchRepositoryDefaultBranchRef
+
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Target
+		src := v.Target
+		var err error
+		*dst, err = __marshalgetDefaultBranchRepositoryDefaultBranchRefTargetGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getDefaultBranchRepositoryDefaultBranchRef.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getDefaultBranchRepositoryDefaultBranchRefTargetBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getDefaultBranchRepositoryDefaultBranchRefTargetBlob struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getDefaultBranchRepositoryDefaultBranchRefTargetBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetBlob) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getDefaultBranchRepositoryDefaultBranchRefTargetBlob.Oid, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetBlob) GetOid() string { return v.Oid }
+
+// getDefaultBranchRepositoryDefaultBranchRefTargetCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getDefaultBranchRepositoryDefaultBranchRefTargetCommit struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getDefaultBranchRepositoryDefaultBranchRefTargetCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetCommit) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getDefaultBranchRepositoryDefaultBranchRefTargetCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetCommit) GetOid() string { return v.Oid }
+
+// getDefaultBranchRepositoryDefaultBranchRefTargetGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getDefaultBranchRepositoryDefaultBranchRefTargetGitObject is implemented by the following types:
+// getDefaultBranchRepositoryDefaultBranchRefTargetBlob
+// getDefaultBranchRepositoryDefaultBranchRefTargetCommit
+// getDefaultBranchRepositoryDefaultBranchRefTargetTag
+// getDefaultBranchRepositoryDefaultBranchRefTargetTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getDefaultBranchRepositoryDefaultBranchRefTargetGitObject interface {
+	implementsGraphQLInterfacegetDefaultBranchRepositoryDefaultBranchRefTargetGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetOid returns the interface-field "oid" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The Git object ID
+	GetOid() string
+}
+
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetBlob) implementsGraphQLInterfacegetDefaultBranchRepositoryDefaultBranchRefTargetGitObject() {
+}
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetCommit) implementsGraphQLInterfacegetDefaultBranchRepositoryDefaultBranchRefTargetGitObject() {
+}
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTag) implementsGraphQLInterfacegetDefaultBranchRepositoryDefaultBranchRefTargetGitObject() {
+}
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTree) implementsGraphQLInterfacegetDefaultBranchRepositoryDefaultBranchRefTargetGitObject() {
+}
+
+func __unmarshalgetDefaultBranchRepositoryDefaultBranchRefTargetGitObject(b []byte, v *getDefaultBranchRepositoryDefaultBranchRefTargetGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getDefaultBranchRepositoryDefaultBranchRefTargetBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getDefaultBranchRepositoryDefaultBranchRefTargetCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getDefaultBranchRepositoryDefaultBranchRefTargetTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getDefaultBranchRepositoryDefaultBranchRefTargetTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getDefaultBranchRepositoryDefaultBranchRefTargetGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetDefaultBranchRepositoryDefaultBranchRefTargetGitObject(v *getDefaultBranchRepositoryDefaultBranchRefTargetGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getDefaultBranchRepositoryDefaultBranchRefTargetBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDefaultBranchRepositoryDefaultBranchRefTargetBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDefaultBranchRepositoryDefaultBranchRefTargetCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDefaultBranchRepositoryDefaultBranchRefTargetCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDefaultBranchRepositoryDefaultBranchRefTargetTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDefaultBranchRepositoryDefaultBranchRefTargetTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDefaultBranchRepositoryDefaultBranchRefTargetTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDefaultBranchRepositoryDefaultBranchRefTargetTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getDefaultBranchRepositoryDefaultBranchRefTargetGitObject: "%T"`, v)
+	}
+}
+
+// getDefaultBranchRepositoryDefaultBranchRefTargetTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getDefaultBranchRepositoryDefaultBranchRefTargetTag struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getDefaultBranchRepositoryDefaultBranchRefTargetTag.Typename, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTag) GetTypename() string { return v.Typename }
+
+// GetOid returns getDefaultBranchRepositoryDefaultBranchRefTargetTag.Oid, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTag) GetOid() string { return v.Oid }
+
+// getDefaultBranchRepositoryDefaultBranchRefTargetTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getDefaultBranchRepositoryDefaultBranchRefTargetTree struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getDefaultBranchRepositoryDefaultBranchRefTargetTree.Typename, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTree) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getDefaultBranchRepositoryDefaultBranchRefTargetTree.Oid, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchRepositoryDefaultBranchRefTargetTree) GetOid() string { return v.Oid }
+
+// getDefaultBranchResponse is returned by getDefaultBranch on success.
+type getDefaultBranchResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getDefaultBranchRepository `json:"repository"`
+}
+
+// GetRepository returns getDefaultBranchResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getDefaultBranchResponse) GetRepository() getDefaultBranchRepository { return v.Repository }
+
+// getGeneratedBranchesRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getGeneratedBranchesRepository struct {
+	// Fetch a list of refs from the repository
+	Refs getGeneratedBranchesRepositoryRefsRefConnection `json:"refs"`
+}
+
+// GetRefs returns getGeneratedBranchesRepository.Refs, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepository) GetRefs() getGeneratedBranchesRepositoryRefsRefConnection {
+	return v.Refs
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnection includes the requested fields of the GraphQL type RefConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Ref.
+type getGeneratedBranchesRepositoryRefsRefConnection struct {
+	// Information to aid in pagination.
+	PageInfo getGeneratedBranchesRepositoryRefsRefConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []getGeneratedBranchesRepositoryRefsRefConnectionNodesRef `json:"nodes"`
+}
+
+// GetPageInfo returns getGeneratedBranchesRepositoryRefsRefConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnection) GetPageInfo() getGeneratedBranchesRepositoryRefsRefConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns getGeneratedBranchesRepositoryRefsRefConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnection) GetNodes() []getGeneratedBranchesRepositoryRefsRefConnectionNodesRef {
+	return v.Nodes
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRef struct {
+	// The Node ID of the Ref object
+	Id string `json:"id"`
+	// The ref name.
+	Name string `json:"name"`
+	// The object the ref points to. Returns null when object does not exist.
+	Target getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject `json:"-"`
+}
+
+// GetId returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRef.Id, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) GetId() string { return v.Id }
+
+// GetName returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRef.Name, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) GetName() string { return v.Name }
+
+// GetTarget returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRef.Target, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) GetTarget() getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject {
+	return v.Target
+}
+
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getGeneratedBranchesRepositoryRefsRefConnectionNodesRef
+		Target json.RawMessage `json:"target"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getGeneratedBranchesRepositoryRefsRefConnectionNodesRef = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Target
+		src := firstPass.Target
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getGeneratedBranchesRepositoryRefsRefConnectionNodesRef.Target: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRef struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Target json.RawMessage `json:"target"`
+}
+
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRef) __premarshalJSON() (*__premarshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRef, error) {
+	var retval __premarshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRef
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Target
+		src := v.Target
+		var err error
+		*dst, err = __marshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getGeneratedBranchesRepositoryRefsRefConnectionNodesRef.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob) GetTypename() string {
+	return v.Typename
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit struct {
+	Typename string `json:"__typename"`
+	// The datetime when this commit was committed.
+	CommittedDate time.Time `json:"committedDate"`
+}
+
+// GetTypename returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit) GetTypename() string {
+	return v.Typename
+}
+
+// GetCommittedDate returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit.CommittedDate, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit) GetCommittedDate() time.Time {
+	return v.CommittedDate
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject is implemented by the following types:
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject interface {
+	implementsGraphQLInterfacegetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob) implementsGraphQLInterfacegetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject() {
+}
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit) implementsGraphQLInterfacegetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject() {
+}
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag) implementsGraphQLInterfacegetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject() {
+}
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree) implementsGraphQLInterfacegetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject() {
+}
+
+func __unmarshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject(b []byte, v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject(v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetGitObject: "%T"`, v)
+	}
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag.Typename, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTag) GetTypename() string {
+	return v.Typename
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree.Typename, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetTree) GetTypename() string {
+	return v.Typename
+}
+
+// getGeneratedBranchesRepositoryRefsRefConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getGeneratedBranchesRepositoryRefsRefConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getGeneratedBranchesRepositoryRefsRefConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getGeneratedBranchesRepositoryRefsRefConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesRepositoryRefsRefConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getGeneratedBranchesResponse is returned by getGeneratedBranches on success.
+type getGeneratedBranchesResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getGeneratedBranchesRepository `json:"repository"`
+}
+
+// GetRepository returns getGeneratedBranchesResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getGeneratedBranchesResponse) GetRepository() getGeneratedBranchesRepository {
+	return v.Repository
+}
+
+// getLatestDeploymentsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getLatestDeploymentsRepository struct {
+	// Deployments associated with the repository
+	Deployments getLatestDeploymentsRepositoryDeploymentsDeploymentConnection `json:"deployments"`
+}
+
+// GetDeployments returns getLatestDeploymentsRepository.Deployments, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepository) GetDeployments() getLatestDeploymentsRepositoryDeploymentsDeploymentConnection {
+	return v.Deployments
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnection includes the requested fields of the GraphQL type DeploymentConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Deployment.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnection struct {
+	// Information to aid in pagination.
+	PageInfo getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment `json:"nodes"`
+}
+
+// GetPageInfo returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnection) GetPageInfo() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnection) GetNodes() []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment {
+	return v.Nodes
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// Represents triggered deployment instance.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment struct {
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The latest environment to which this deployment was made.
+	Environment string `json:"environment"`
+	// Identifies the commit sha of the deployment.
+	Commit getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit `json:"commit"`
+	// The deployment description.
+	Description string `json:"description"`
+	// The current state of the deployment.
+	State DeploymentState `json:"state"`
+	// A list of statuses associated with the deployment.
+	Statuses getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection `json:"statuses"`
+}
+
+// GetCreatedAt returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetEnvironment returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Environment, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetEnvironment() string {
+	return v.Environment
+}
+
+// GetCommit returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Commit, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetCommit() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit {
+	return v.Commit
+}
+
+// GetDescription returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Description, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetDescription() string {
+	return v.Description
+}
+
+// GetState returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.State, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetState() DeploymentState {
+	return v.State
+}
+
+// GetStatuses returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment.Statuses, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment) GetStatuses() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection {
+	return v.Statuses
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+	// The Git commit message
+	Message string `json:"message"`
+	// The datetime when this commit was committed.
+	CommittedDate time.Time `json:"committedDate"`
+	// The merged Pull Request that introduced the commit to the repository. If the
+	// commit is not present in the default branch, additionally returns open Pull
+	// Requests associated with the commit
+	AssociatedPullRequests getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection `json:"associatedPullRequests"`
+}
+
+// GetOid returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit) GetOid() string {
+	return v.Oid
+}
+
+// GetMessage returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit.Message, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit) GetMessage() string {
+	return v.Message
+}
+
+// GetCommittedDate returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit.CommittedDate, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit) GetCommittedDate() time.Time {
+	return v.CommittedDate
+}
+
+// GetAssociatedPullRequests returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit.AssociatedPullRequests, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommit) GetAssociatedPullRequests() getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection {
+	return v.AssociatedPullRequests
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection includes the requested fields of the GraphQL type PullRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequest.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection struct {
+	// A list of nodes.
+	Nodes []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest `json:"nodes"`
+}
+
+// GetNodes returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnection) GetNodes() []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest {
+	return v.Nodes
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest struct {
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetCreatedAt returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentCommitAssociatedPullRequestsPullRequestConnectionNodesPullRequest) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection includes the requested fields of the GraphQL type DeploymentStatusConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for DeploymentStatus.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection struct {
+	// A list of nodes.
+	Nodes []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus `json:"nodes"`
+}
+
+// GetNodes returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnection) GetNodes() []getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus {
+	return v.Nodes
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus includes the requested fields of the GraphQL type DeploymentStatus.
+// The GraphQL type's documentation follows.
+//
+// Describes the status of a given deployment attempt.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus struct {
+	// Identifies the date and time when the object was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Identifies the current state of the deployment.
+	State DeploymentStatusState `json:"state"`
+	// Identifies the environment of the deployment at the time of this deployment status
+	Environment string `json:"environment"`
+}
+
+// GetUpdatedAt returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetState returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.State, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetState() DeploymentStatusState {
+	return v.State
+}
+
+// GetEnvironment returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus.Environment, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus) GetEnvironment() string {
+	return v.Environment
+}
+
+// getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getLatestDeploymentsResponse is returned by getLatestDeployments on success.
+type getLatestDeploymentsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getLatestDeploymentsRepository `json:"repository"`
+}
+
+// GetRepository returns getLatestDeploymentsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getLatestDeploymentsResponse) GetRepository() getLatestDeploymentsRepository {
+	return v.Repository
+}
+
+// getOpenPullRequestsRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getOpenPullRequestsRepository struct {
+	// A list of pull requests that have been opened in the repository.
+	PullRequests getOpenPullRequestsRepositoryPullRequestsPullRequestConnection `json:"pullRequests"`
+}
+
+// GetPullRequests returns getOpenPullRequestsRepository.PullRequests, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepository) GetPullRequests() getOpenPullRequestsRepositoryPullRequestsPullRequestConnection {
+	return v.PullRequests
+}
+
+// getOpenPullRequestsRepositoryPullRequestsPullRequestConnection includes the requested fields of the GraphQL type PullRequestConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequest.
+type getOpenPullRequestsRepositoryPullRequestsPullRequestConnection struct {
+	// Information to aid in pagination.
+	PageInfo getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo `json:"pageInfo"`
+	// A list of nodes.
+	Nodes []getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest `json:"nodes"`
+}
+
+// GetPageInfo returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnection) GetPageInfo() getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetNodes returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnection) GetNodes() []getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest {
+	return v.Nodes
+}
+
+// getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+	// Identifies the pull request number.
+	Number int `json:"number"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
+	HeadRefName string `json:"headRefName"`
+	// The body as Markdown.
+	Body string `json:"body"`
+	// A list of labels associated with the object.
+	Labels getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection `json:"labels"`
+}
+
+// GetId returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetId() string {
+	return v.Id
+}
+
+// GetNumber returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Number, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetNumber() int {
+	return v.Number
+}
+
+// GetCreatedAt returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetHeadRefName returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.HeadRefName, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetHeadRefName() string {
+	return v.HeadRefName
+}
+
+// GetBody returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Body, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetBody() string {
+	return v.Body
+}
+
+// GetLabels returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest.Labels, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequest) GetLabels() getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection {
+	return v.Labels
+}
+
+// getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection includes the requested fields of the GraphQL type LabelConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for Label.
+type getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection struct {
+	// A list of nodes.
+	Nodes []getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel `json:"nodes"`
+}
+
+// GetNodes returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnection) GetNodes() []getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel {
+	return v.Nodes
+}
+
+// getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel includes the requested fields of the GraphQL type Label.
+// The GraphQL type's documentation follows.
+//
+// A label for categorizing Issues, Pull Requests, Milestones, or Discussions with a given Repository.
+type getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel struct {
+	// Identifies the label name.
+	Name string `json:"name"`
+}
+
+// GetName returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel.Name, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionNodesPullRequestLabelsLabelConnectionNodesLabel) GetName() string {
+	return v.Name
+}
+
+// getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo struct {
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+	// When paginating forwards, the cursor to continue.
+	EndCursor string `json:"endCursor"`
+}
+
+// GetHasNextPage returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsRepositoryPullRequestsPullRequestConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// getOpenPullRequestsResponse is returned by getOpenPullRequests on success.
+type getOpenPullRequestsResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getOpenPullRequestsRepository `json:"repository"`
+}
+
+// GetRepository returns getOpenPullRequestsResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getOpenPullRequestsResponse) GetRepository() getOpenPullRequestsRepository {
+	return v.Repository
+}
+
+// getPullRequestHeadRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestHeadRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest getPullRequestHeadRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestHeadRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepository) GetPullRequest() getPullRequestHeadRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestHeadRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestHeadRepositoryPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+	// The body as Markdown.
+	Body string `json:"body"`
+	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
+	HeadRefName string `json:"headRefName"`
+	// Identifies the oid of the head ref associated with the pull request, even if the ref has been deleted.
+	HeadRefOid string `json:"headRefOid"`
+	// Identifies the head Ref associated with the pull request.
+	HeadRef getPullRequestHeadRepositoryPullRequestHeadRef `json:"headRef"`
+}
+
+// GetId returns getPullRequestHeadRepositoryPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequest) GetId() string { return v.Id }
+
+// GetBody returns getPullRequestHeadRepositoryPullRequest.Body, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequest) GetBody() string { return v.Body }
+
+// GetHeadRefName returns getPullRequestHeadRepositoryPullRequest.HeadRefName, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequest) GetHeadRefName() string { return v.HeadRefName }
+
+// GetHeadRefOid returns getPullRequestHeadRepositoryPullRequest.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequest) GetHeadRefOid() string { return v.HeadRefOid }
+
+// GetHeadRef returns getPullRequestHeadRepositoryPullRequest.HeadRef, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequest) GetHeadRef() getPullRequestHeadRepositoryPullRequestHeadRef {
+	return v.HeadRef
+}
+
+// getPullRequestHeadRepositoryPullRequestHeadRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getPullRequestHeadRepositoryPullRequestHeadRef struct {
+	// The Node ID of the Ref object
+	Id string `json:"id"`
+}
+
+// GetId returns getPullRequestHeadRepositoryPullRequestHeadRef.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadRepositoryPullRequestHeadRef) GetId() string { return v.Id }
+
+// getPullRequestHeadResponse is returned by getPullRequestHead on success.
+type getPullRequestHeadResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getPullRequestHeadRepository `json:"repository"`
+}
+
+// GetRepository returns getPullRequestHeadResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestHeadResponse) GetRepository() getPullRequestHeadRepository {
+	return v.Repository
+}
+
+// getPullRequestMergeStateRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestMergeStateRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest getPullRequestMergeStateRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestMergeStateRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepository) GetPullRequest() getPullRequestMergeStateRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestMergeStateRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestMergeStateRepositoryPullRequest struct {
+	// Identifies the state of the pull request.
+	State PullRequestState `json:"state"`
+	// Whether or not the pull request was merged.
+	Merged bool `json:"merged"`
+	// The date and time that the pull request was merged.
+	MergedAt time.Time `json:"mergedAt"`
+	// Detailed information about the current pull request merge state status.
+	MergeStateStatus MergeStateStatus `json:"mergeStateStatus"`
+	// Indicates whether the pull request is in a merge queue
+	IsInMergeQueue bool `json:"isInMergeQueue"`
+	// Check and Status rollup information for the PR's head ref.
+	StatusCheckRollup getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup `json:"statusCheckRollup"`
+	// The commit that was created when this pull request was merged.
+	MergeCommit getPullRequestMergeStateRepositoryPullRequestMergeCommit `json:"mergeCommit"`
+}
+
+// GetState returns getPullRequestMergeStateRepositoryPullRequest.State, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetState() PullRequestState { return v.State }
+
+// GetMerged returns getPullRequestMergeStateRepositoryPullRequest.Merged, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetMerged() bool { return v.Merged }
+
+// GetMergedAt returns getPullRequestMergeStateRepositoryPullRequest.MergedAt, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetMergedAt() time.Time { return v.MergedAt }
+
+// GetMergeStateStatus returns getPullRequestMergeStateRepositoryPullRequest.MergeStateStatus, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetMergeStateStatus() MergeStateStatus {
+	return v.MergeStateStatus
+}
+
+// GetIsInMergeQueue returns getPullRequestMergeStateRepositoryPullRequest.IsInMergeQueue, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetIsInMergeQueue() bool {
+	return v.IsInMergeQueue
+}
+
+// GetStatusCheckRollup returns getPullRequestMergeStateRepositoryPullRequest.StatusCheckRollup, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetStatusCheckRollup() getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup {
+	return v.StatusCheckRollup
+}
+
+// GetMergeCommit returns getPullRequestMergeStateRepositoryPullRequest.MergeCommit, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequest) GetMergeCommit() getPullRequestMergeStateRepositoryPullRequestMergeCommit {
+	return v.MergeCommit
+}
+
+// getPullRequestMergeStateRepositoryPullRequestMergeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getPullRequestMergeStateRepositoryPullRequestMergeCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetOid returns getPullRequestMergeStateRepositoryPullRequestMergeCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequestMergeCommit) GetOid() string { return v.Oid }
+
+// getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup struct {
+	// The combined status for the commit.
+	State StatusState `json:"state"`
+}
+
+// GetState returns getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup.State, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateRepositoryPullRequestStatusCheckRollup) GetState() StatusState {
+	return v.State
+}
+
+// getPullRequestMergeStateResponse is returned by getPullRequestMergeState on success.
+type getPullRequestMergeStateResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getPullRequestMergeStateRepository `json:"repository"`
+}
+
+// GetRepository returns getPullRequestMergeStateResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeStateResponse) GetRepository() getPullRequestMergeStateRepository {
+	return v.Repository
+}
+
+// getPullRequestMergeabilityRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestMergeabilityRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest getPullRequestMergeabilityRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestMergeabilityRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepository) GetPullRequest() getPullRequestMergeabilityRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestMergeabilityRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestMergeabilityRepositoryPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+	// Whether or not the pull request can be merged based on the existence of merge conflicts.
+	Mergeable MergeableState `json:"mergeable"`
+	// Detailed information about the current pull request merge state status.
+	MergeStateStatus MergeStateStatus `json:"mergeStateStatus"`
+	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
+	HeadRefName string `json:"headRefName"`
+	// Identifies the oid of the head ref associated with the pull request, even if the ref has been deleted.
+	HeadRefOid string `json:"headRefOid"`
+	// Identifies the head Ref associated with the pull request.
+	HeadRef getPullRequestMergeabilityRepositoryPullRequestHeadRef `json:"headRef"`
+	// Identifies the base Ref associated with the pull request.
+	BaseRef getPullRequestMergeabilityRepositoryPullRequestBaseRef `json:"baseRef"`
+}
+
+// GetId returns getPullRequestMergeabilityRepositoryPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetId() string { return v.Id }
+
+// GetMergeable returns getPullRequestMergeabilityRepositoryPullRequest.Mergeable, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetMergeable() MergeableState {
+	return v.Mergeable
+}
+
+// GetMergeStateStatus returns getPullRequestMergeabilityRepositoryPullRequest.MergeStateStatus, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetMergeStateStatus() MergeStateStatus {
+	return v.MergeStateStatus
+}
+
+// GetHeadRefName returns getPullRequestMergeabilityRepositoryPullRequest.HeadRefName, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetHeadRefName() string {
+	return v.HeadRefName
+}
+
+// GetHeadRefOid returns getPullRequestMergeabilityRepositoryPullRequest.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetHeadRefOid() string { return v.HeadRefOid }
+
+// GetHeadRef returns getPullRequestMergeabilityRepositoryPullRequest.HeadRef, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetHeadRef() getPullRequestMergeabilityRepositoryPullRequestHeadRef {
+	return v.HeadRef
+}
+
+// GetBaseRef returns getPullRequestMergeabilityRepositoryPullRequest.BaseRef, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequest) GetBaseRef() getPullRequestMergeabilityRepositoryPullRequestBaseRef {
+	return v.BaseRef
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRef struct {
+	// The object the ref points to. Returns null when object does not exist.
+	Target getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject `json:"-"`
+}
+
+// GetTarget returns getPullRequestMergeabilityRepositoryPullRequestBaseRef.Target, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRef) GetTarget() getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject {
+	return v.Target
+}
+
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRef) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPullRequestMergeabilityRepositoryPullRequestBaseRef
+		Target json.RawMessage `json:"target"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPullRequestMergeabilityRepositoryPullRequestBaseRef = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Target
+		src := firstPass.Target
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getPullRequestMergeabilityRepositoryPullRequestBaseRef.Target: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetPullRequestMergeabilityRepositoryPullRequestBaseRef struct {
+	Target json.RawMessage `json:"target"`
+}
+
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRef) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRef) __premarshalJSON() (*__premarshalgetPullRequestMergeabilityRepositoryPullRequestBaseRef, error) {
+	var retval __premarshalgetPullRequestMergeabilityRepositoryPullRequestBaseRef
+
+	{
+
+		dst := &retval.Target
+		src := v.Target
+		var err error
+		*dst, err = __marshalgetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getPullRequestMergeabilityRepositoryPullRequestBaseRef.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob) GetOid() string {
+	return v.Oid
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit) GetOid() string {
+	return v.Oid
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject is implemented by the following types:
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject interface {
+	implementsGraphQLInterfacegetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetOid returns the interface-field "oid" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The Git object ID
+	GetOid() string
+}
+
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob) implementsGraphQLInterfacegetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject() {
+}
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit) implementsGraphQLInterfacegetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject() {
+}
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag) implementsGraphQLInterfacegetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject() {
+}
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree) implementsGraphQLInterfacegetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject() {
+}
+
+func __unmarshalgetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject(b []byte, v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject(v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetGitObject: "%T"`, v)
+	}
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTag) GetOid() string {
+	return v.Oid
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree.Typename, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree) GetTypename() string {
+	return v.Typename
+}
+
+// GetOid returns getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree.Oid, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestBaseRefTargetTree) GetOid() string {
+	return v.Oid
+}
+
+// getPullRequestMergeabilityRepositoryPullRequestHeadRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type getPullRequestMergeabilityRepositoryPullRequestHeadRef struct {
+	// The Node ID of the Ref object
+	Id string `json:"id"`
+}
+
+// GetId returns getPullRequestMergeabilityRepositoryPullRequestHeadRef.Id, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityRepositoryPullRequestHeadRef) GetId() string { return v.Id }
+
+// getPullRequestMergeabilityResponse is returned by getPullRequestMergeability on success.
+type getPullRequestMergeabilityResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getPullRequestMergeabilityRepository `json:"repository"`
+}
+
+// GetRepository returns getPullRequestMergeabilityResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestMergeabilityResponse) GetRepository() getPullRequestMergeabilityRepository {
+	return v.Repository
+}
+
+// getPullRequestStatusCheckRollupRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getPullRequestStatusCheckRollupRepository struct {
+	// Returns a single pull request from the current repository by number.
+	PullRequest getPullRequestStatusCheckRollupRepositoryPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns getPullRequestStatusCheckRollupRepository.PullRequest, and is useful for accessing the field via an interface.
+func (v *getPullRequestStatusCheckRollupRepository) GetPullRequest() getPullRequestStatusCheckRollupRepositoryPullRequest {
+	return v.PullRequest
+}
+
+// getPullRequestStatusCheckRollupRepositoryPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type getPullRequestStatusCheckRollupRepositoryPullRequest struct {
+	// Identifies the oid of the head ref associated with the pull request, even if the ref has been deleted.
+	HeadRefOid string `json:"headRefOid"`
+	// Check and Status rollup information for the PR's head ref.
+	StatusCheckRollup getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup `json:"statusCheckRollup"`
+}
+
+// GetHeadRefOid returns getPullRequestStatusCheckRollupRepositoryPullRequest.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *getPullRequestStatusCheckRollupRepositoryPullRequest) GetHeadRefOid() string {
+	return v.HeadRefOid
+}
+
+// GetStatusCheckRollup returns getPullRequestStatusCheckRollupRepositoryPullRequest.StatusCheckRollup, and is useful for accessing the field via an interface.
+func (v *getPullRequestStatusCheckRollupRepositoryPullRequest) GetStatusCheckRollup() getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup {
+	return v.StatusCheckRollup
+}
+
+// getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup includes the requested fields of the GraphQL type StatusCheckRollup.
+// The GraphQL type's documentation follows.
+//
+// Represents the rollup for both the check runs and status for a commit.
+type getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup struct {
+	// The combined status for the commit.
+	State StatusState `json:"state"`
+}
+
+// GetState returns getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup.State, and is useful for accessing the field via an interface.
+func (v *getPullRequestStatusCheckRollupRepositoryPullRequestStatusCheckRollup) GetState() StatusState {
+	return v.State
+}
+
+// getPullRequestStatusCheckRollupResponse is returned by getPullRequestStatusCheckRollup on success.
+type getPullRequestStatusCheckRollupResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getPullRequestStatusCheckRollupRepository `json:"repository"`
+}
+
+// GetRepository returns getPullRequestStatusCheckRollupResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getPullRequestStatusCheckRollupResponse) GetRepository() getPullRequestStatusCheckRollupRepository {
+	return v.Repository
+}
+
+// getRepoIdRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepoIdRepository struct {
+	// The Node ID of the Repository object
+	Id string `json:"id"`
+}
+
+// GetId returns getRepoIdRepository.Id, and is useful for accessing the field via an interface.
+func (v *getRepoIdRepository) GetId() string { return v.Id }
+
+// getRepoIdResponse is returned by getRepoId on success.
+type getRepoIdResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepoIdRepository `json:"repository"`
+}
+
+// GetRepository returns getRepoIdResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepoIdResponse) GetRepository() getRepoIdRepository { return v.Repository }
+
+// getRepositoryFileRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryFileRepository struct {
+	// A Git object in the repository
+	Object getRepositoryFileRepositoryObjectGitObject `json:"-"`
+}
+
+// GetObject returns getRepositoryFileRepository.Object, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepository) GetObject() getRepositoryFileRepositoryObjectGitObject {
+	return v.Object
+}
+
+func (v *getRepositoryFileRepository) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryFileRepository
+		Object json.RawMessage `json:"object"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryFileRepository = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Object
+		src := firstPass.Object
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetRepositoryFileRepositoryObjectGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getRepositoryFileRepository.Object: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryFileRepository struct {
+	Object json.RawMessage `json:"object"`
+}
+
+func (v *getRepositoryFileRepository) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryFileRepository) __premarshalJSON() (*__premarshalgetRepositoryFileRepository, error) {
+	var retval __premarshalgetRepositoryFileRepository
+
+	{
+
+		dst := &retval.Object
+		src := v.Object
+		var err error
+		*dst, err = __marshalgetRepositoryFileRepositoryObjectGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getRepositoryFileRepository.Object: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getRepositoryFileRepositoryObjectBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type getRepositoryFileRepositoryObjectBlob struct {
+	Typename string `json:"__typename"`
+	// UTF8 text data or null if the Blob is binary
+	Text string `json:"text"`
+	// Indicates whether the Blob is binary or text. Returns null if unable to determine the encoding.
+	IsBinary bool `json:"isBinary"`
+}
+
+// GetTypename returns getRepositoryFileRepositoryObjectBlob.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectBlob) GetTypename() string { return v.Typename }
+
+// GetText returns getRepositoryFileRepositoryObjectBlob.Text, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectBlob) GetText() string { return v.Text }
+
+// GetIsBinary returns getRepositoryFileRepositoryObjectBlob.IsBinary, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectBlob) GetIsBinary() bool { return v.IsBinary }
+
+// getRepositoryFileRepositoryObjectCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type getRepositoryFileRepositoryObjectCommit struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRepositoryFileRepositoryObjectCommit.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectCommit) GetTypename() string { return v.Typename }
+
+// getRepositoryFileRepositoryObjectGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// getRepositoryFileRepositoryObjectGitObject is implemented by the following types:
+// getRepositoryFileRepositoryObjectBlob
+// getRepositoryFileRepositoryObjectCommit
+// getRepositoryFileRepositoryObjectTag
+// getRepositoryFileRepositoryObjectTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type getRepositoryFileRepositoryObjectGitObject interface {
+	implementsGraphQLInterfacegetRepositoryFileRepositoryObjectGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getRepositoryFileRepositoryObjectBlob) implementsGraphQLInterfacegetRepositoryFileRepositoryObjectGitObject() {
+}
+func (v *getRepositoryFileRepositoryObjectCommit) implementsGraphQLInterfacegetRepositoryFileRepositoryObjectGitObject() {
+}
+func (v *getRepositoryFileRepositoryObjectTag) implementsGraphQLInterfacegetRepositoryFileRepositoryObjectGitObject() {
+}
+func (v *getRepositoryFileRepositoryObjectTree) implementsGraphQLInterfacegetRepositoryFileRepositoryObjectGitObject() {
+}
+
+func __unmarshalgetRepositoryFileRepositoryObjectGitObject(b []byte, v *getRepositoryFileRepositoryObjectGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(getRepositoryFileRepositoryObjectBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(getRepositoryFileRepositoryObjectCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(getRepositoryFileRepositoryObjectTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(getRepositoryFileRepositoryObjectTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getRepositoryFileRepositoryObjectGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetRepositoryFileRepositoryObjectGitObject(v *getRepositoryFileRepositoryObjectGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getRepositoryFileRepositoryObjectBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryFileRepositoryObjectBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRepositoryFileRepositoryObjectCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryFileRepositoryObjectCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRepositoryFileRepositoryObjectTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryFileRepositoryObjectTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRepositoryFileRepositoryObjectTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryFileRepositoryObjectTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getRepositoryFileRepositoryObjectGitObject: "%T"`, v)
+	}
+}
+
+// getRepositoryFileRepositoryObjectTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type getRepositoryFileRepositoryObjectTag struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRepositoryFileRepositoryObjectTag.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectTag) GetTypename() string { return v.Typename }
+
+// getRepositoryFileRepositoryObjectTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type getRepositoryFileRepositoryObjectTree struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRepositoryFileRepositoryObjectTree.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileRepositoryObjectTree) GetTypename() string { return v.Typename }
+
+// getRepositoryFileResponse is returned by getRepositoryFile on success.
+type getRepositoryFileResponse struct {
+	// Lookup a given repository by the owner and repository name.
+	Repository getRepositoryFileRepository `json:"repository"`
+}
+
+// GetRepository returns getRepositoryFileResponse.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryFileResponse) GetRepository() getRepositoryFileRepository { return v.Repository }
+
+// getRepositoryOwnerRepositoryOwner includes the requested fields of the GraphQL interface RepositoryOwner.
+//
+// getRepositoryOwnerRepositoryOwner is implemented by the following types:
+// getRepositoryOwnerRepositoryOwnerOrganization
+// getRepositoryOwnerRepositoryOwnerUser
+// The GraphQL type's documentation follows.
+//
+// Represents an owner of a Repository.
+type getRepositoryOwnerRepositoryOwner interface {
+	implementsGraphQLInterfacegetRepositoryOwnerRepositoryOwner()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The Node ID of the RepositoryOwner object
+	GetId() string
+	// GetRepository returns the interface-field "repository" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Find Repository.
+	GetRepository() getRepositoryOwnerRepositoryOwnerRepository
+}
+
+func (v *getRepositoryOwnerRepositoryOwnerOrganization) implementsGraphQLInterfacegetRepositoryOwnerRepositoryOwner() {
+}
+func (v *getRepositoryOwnerRepositoryOwnerUser) implementsGraphQLInterfacegetRepositoryOwnerRepositoryOwner() {
+}
+
+func __unmarshalgetRepositoryOwnerRepositoryOwner(b []byte, v *getRepositoryOwnerRepositoryOwner) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Organization":
+		*v = new(getRepositoryOwnerRepositoryOwnerOrganization)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getRepositoryOwnerRepositoryOwnerUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing RepositoryOwner.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getRepositoryOwnerRepositoryOwner: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetRepositoryOwnerRepositoryOwner(v *getRepositoryOwnerRepositoryOwner) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getRepositoryOwnerRepositoryOwnerOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryOwnerRepositoryOwnerOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRepositoryOwnerRepositoryOwnerUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRepositoryOwnerRepositoryOwnerUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getRepositoryOwnerRepositoryOwner: "%T"`, v)
+	}
+}
+
+// getRepositoryOwnerRepositoryOwnerOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type getRepositoryOwnerRepositoryOwnerOrganization struct {
+	Typename string `json:"__typename"`
+	// The Node ID of the RepositoryOwner object
+	Id string `json:"id"`
+	// Find Repository.
+	Repository getRepositoryOwnerRepositoryOwnerRepository `json:"repository"`
+}
+
+// GetTypename returns getRepositoryOwnerRepositoryOwnerOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerOrganization) GetTypename() string { return v.Typename }
+
+// GetId returns getRepositoryOwnerRepositoryOwnerOrganization.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerOrganization) GetId() string { return v.Id }
+
+// GetRepository returns getRepositoryOwnerRepositoryOwnerOrganization.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerOrganization) GetRepository() getRepositoryOwnerRepositoryOwnerRepository {
+	return v.Repository
+}
+
+// getRepositoryOwnerRepositoryOwnerRepository includes the requested fields of the GraphQL type Repository.
+// The GraphQL type's documentation follows.
+//
+// A repository contains the content for a project.
+type getRepositoryOwnerRepositoryOwnerRepository struct {
+	// The Node ID of the Repository object
+	Id string `json:"id"`
+	// Returns whether or not this repository is empty.
+	IsEmpty bool `json:"isEmpty"`
+}
+
+// GetId returns getRepositoryOwnerRepositoryOwnerRepository.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerRepository) GetId() string { return v.Id }
+
+// GetIsEmpty returns getRepositoryOwnerRepositoryOwnerRepository.IsEmpty, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerRepository) GetIsEmpty() bool { return v.IsEmpty }
+
+// getRepositoryOwnerRepositoryOwnerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getRepositoryOwnerRepositoryOwnerUser struct {
+	Typename string `json:"__typename"`
+	// The Node ID of the RepositoryOwner object
+	Id string `json:"id"`
+	// Find Repository.
+	Repository getRepositoryOwnerRepositoryOwnerRepository `json:"repository"`
+}
+
+// GetTypename returns getRepositoryOwnerRepositoryOwnerUser.Typename, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerUser) GetTypename() string { return v.Typename }
+
+// GetId returns getRepositoryOwnerRepositoryOwnerUser.Id, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerUser) GetId() string { return v.Id }
+
+// GetRepository returns getRepositoryOwnerRepositoryOwnerUser.Repository, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerRepositoryOwnerUser) GetRepository() getRepositoryOwnerRepositoryOwnerRepository {
+	return v.Repository
+}
+
+// getRepositoryOwnerResponse is returned by getRepositoryOwner on success.
+type getRepositoryOwnerResponse struct {
+	// Lookup a repository owner (ie. either a User or an Organization) by login.
+	RepositoryOwner getRepositoryOwnerRepositoryOwner `json:"-"`
+}
+
+// GetRepositoryOwner returns getRepositoryOwnerResponse.RepositoryOwner, and is useful for accessing the field via an interface.
+func (v *getRepositoryOwnerResponse) GetRepositoryOwner() getRepositoryOwnerRepositoryOwner {
+	return v.RepositoryOwner
+}
+
+func (v *getRepositoryOwnerResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRepositoryOwnerResponse
+		RepositoryOwner json.RawMessage `json:"repositoryOwner"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRepositoryOwnerResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RepositoryOwner
+		src := firstPass.RepositoryOwner
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetRepositoryOwnerRepositoryOwner(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getRepositoryOwnerResponse.RepositoryOwner: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRepositoryOwnerResponse struct {
+	RepositoryOwner json.RawMessage `json:"repositoryOwner"`
+}
+
+func (v *getRepositoryOwnerResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRepositoryOwnerResponse) __premarshalJSON() (*__premarshalgetRepositoryOwnerResponse, error) {
+	var retval __premarshalgetRepositoryOwnerResponse
+
+	{
+
+		dst := &retval.RepositoryOwner
+		src := v.RepositoryOwner
+		var err error
+		*dst, err = __marshalgetRepositoryOwnerRepositoryOwner(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal getRepositoryOwnerResponse.RepositoryOwner: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// getUserResponse is returned by getUser on success.
+type getUserResponse struct {
+	// Lookup a user by login.
+	User getUserUser `json:"user"`
+}
+
+// GetUser returns getUserResponse.User, and is useful for accessing the field via an interface.
+func (v *getUserResponse) GetUser() getUserUser { return v.User }
+
+// getUserUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getUserUser struct {
+	// The user's public profile name.
+	TheirName string `json:"theirName"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetTheirName returns getUserUser.TheirName, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetTheirName() string { return v.TheirName }
+
+// GetCreatedAt returns getUserUser.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getUserUser) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// getViewerIdentityResponse is returned by getViewerIdentity on success.
+type getViewerIdentityResponse struct {
+	// The currently authenticated user.
+	Viewer getViewerIdentityViewerUser `json:"viewer"`
+}
+
+// GetViewer returns getViewerIdentityResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerIdentityResponse) GetViewer() getViewerIdentityViewerUser { return v.Viewer }
+
+// getViewerIdentityViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getViewerIdentityViewerUser struct {
+	// The Node ID of the User object
+	Id string `json:"id"`
+	// The username used to login.
+	Login string `json:"login"`
+}
+
+// GetId returns getViewerIdentityViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *getViewerIdentityViewerUser) GetId() string { return v.Id }
+
+// GetLogin returns getViewerIdentityViewerUser.Login, and is useful for accessing the field via an interface.
+func (v *getViewerIdentityViewerUser) GetLogin() string { return v.Login }
+
+// getViewerResponse is returned by getViewer on success.
+type getViewerResponse struct {
+	// The currently authenticated user.
+	Viewer getViewerViewerUser `json:"viewer"`
+}
+
+// GetViewer returns getViewerResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *getViewerResponse) GetViewer() getViewerViewerUser { return v.Viewer }
+
+// getViewerViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type getViewerViewerUser struct {
+	// The user's public profile name.
+	MyName string `json:"MyName"`
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetMyName returns getViewerViewerUser.MyName, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetMyName() string { return v.MyName }
+
+// GetCreatedAt returns getViewerViewerUser.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getViewerViewerUser) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// mergePullRequestMergePullRequestMergePullRequestPayload includes the requested fields of the GraphQL type MergePullRequestPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of MergePullRequest
+type mergePullRequestMergePullRequestMergePullRequestPayload struct {
+	// The pull request that was merged.
+	PullRequest mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns mergePullRequestMergePullRequestMergePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *mergePullRequestMergePullRequestMergePullRequestPayload) GetPullRequest() mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest {
+	return v.PullRequest
+}
+
+// mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest struct {
+	// Whether or not the pull request was merged.
+	Merged bool `json:"merged"`
+	// The date and time that the pull request was merged.
+	MergedAt time.Time `json:"mergedAt"`
+	// The commit that was created when this pull request was merged.
+	MergeCommit mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit `json:"mergeCommit"`
+}
+
+// GetMerged returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.Merged, and is useful for accessing the field via an interface.
+func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMerged() bool {
+	return v.Merged
+}
+
+// GetMergedAt returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.MergedAt, and is useful for accessing the field via an interface.
+func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMergedAt() time.Time {
+	return v.MergedAt
+}
+
+// GetMergeCommit returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.MergeCommit, and is useful for accessing the field via an interface.
+func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMergeCommit() mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit {
+	return v.MergeCommit
+}
+
+// mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetOid returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit.Oid, and is useful for accessing the field via an interface.
+func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit) GetOid() string {
+	return v.Oid
+}
+
+// mergePullRequestResponse is returned by mergePullRequest on success.
+type mergePullRequestResponse struct {
+	// Merge a pull request.
+	MergePullRequest mergePullRequestMergePullRequestMergePullRequestPayload `json:"mergePullRequest"`
+}
+
+// GetMergePullRequest returns mergePullRequestResponse.MergePullRequest, and is useful for accessing the field via an interface.
+func (v *mergePullRequestResponse) GetMergePullRequest() mergePullRequestMergePullRequestMergePullRequestPayload {
+	return v.MergePullRequest
+}
+
+// requestReviewsRequestReviewsRequestReviewsPayload includes the requested fields of the GraphQL type RequestReviewsPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of RequestReviews
+type requestReviewsRequestReviewsRequestReviewsPayload struct {
+	// The pull request that is getting requests.
+	PullRequest requestReviewsRequestReviewsRequestReviewsPayloadPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns requestReviewsRequestReviewsRequestReviewsPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *requestReviewsRequestReviewsRequestReviewsPayload) GetPullRequest() requestReviewsRequestReviewsRequestReviewsPayloadPullRequest {
+	return v.PullRequest
+}
+
+// requestReviewsRequestReviewsRequestReviewsPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type requestReviewsRequestReviewsRequestReviewsPayloadPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+}
+
+// GetId returns requestReviewsRequestReviewsRequestReviewsPayloadPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *requestReviewsRequestReviewsRequestReviewsPayloadPullRequest) GetId() string { return v.Id }
+
+// requestReviewsResponse is returned by requestReviews on success.
+type requestReviewsResponse struct {
+	// Set review requests on a pull request.
+	RequestReviews requestReviewsRequestReviewsRequestReviewsPayload `json:"requestReviews"`
+}
+
+// GetRequestReviews returns requestReviewsResponse.RequestReviews, and is useful for accessing the field via an interface.
+func (v *requestReviewsResponse) GetRequestReviews() requestReviewsRequestReviewsRequestReviewsPayload {
+	return v.RequestReviews
+}
+
+// updatePullRequestBodyResponse is returned by updatePullRequestBody on success.
+type updatePullRequestBodyResponse struct {
+	// Update a pull request
+	UpdatePullRequest updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload `json:"updatePullRequest"`
+}
+
+// GetUpdatePullRequest returns updatePullRequestBodyResponse.UpdatePullRequest, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBodyResponse) GetUpdatePullRequest() updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload {
+	return v.UpdatePullRequest
+}
+
+// updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload includes the requested fields of the GraphQL type UpdatePullRequestPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdatePullRequest
+type updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload struct {
+	// The updated pull request.
+	PullRequest updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayload) GetPullRequest() updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest {
+	return v.PullRequest
+}
+
+// updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest struct {
+	// The Node ID of the PullRequest object
+	Id string `json:"id"`
+}
+
+// GetId returns updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest.Id, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBodyUpdatePullRequestUpdatePullRequestPayloadPullRequest) GetId() string {
+	return v.Id
+}
+
+// updatePullRequestBranchResponse is returned by updatePullRequestBranch on success.
+type updatePullRequestBranchResponse struct {
+	// Merge or Rebase HEAD from upstream branch into pull request branch
+	UpdatePullRequestBranch updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload `json:"updatePullRequestBranch"`
+}
+
+// GetUpdatePullRequestBranch returns updatePullRequestBranchResponse.UpdatePullRequestBranch, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBranchResponse) GetUpdatePullRequestBranch() updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload {
+	return v.UpdatePullRequestBranch
+}
+
+// updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload includes the requested fields of the GraphQL type UpdatePullRequestBranchPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdatePullRequestBranch
+type updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload struct {
+	// The updated pull request.
+	PullRequest updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest `json:"pullRequest"`
+}
+
+// GetPullRequest returns updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload.PullRequest, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayload) GetPullRequest() updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest {
+	return v.PullRequest
+}
+
+// updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest struct {
+	// Identifies the oid of the head ref associated with the pull request, even if the ref has been deleted.
+	HeadRefOid string `json:"headRefOid"`
+}
+
+// GetHeadRefOid returns updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest.HeadRefOid, and is useful for accessing the field via an interface.
+func (v *updatePullRequestBranchUpdatePullRequestBranchUpdatePullRequestBranchPayloadPullRequest) GetHeadRefOid() string {
+	return v.HeadRefOid
+}
+
+// updateRefResponse is returned by updateRef on success.
+type updateRefResponse struct {
+	// Update a Git Ref.
+	UpdateRef updateRefUpdateRefUpdateRefPayload `json:"updateRef"`
+}
+
+// GetUpdateRef returns updateRefResponse.UpdateRef, and is useful for accessing the field via an interface.
+func (v *updateRefResponse) GetUpdateRef() updateRefUpdateRefUpdateRefPayload { return v.UpdateRef }
+
+// updateRefUpdateRefUpdateRefPayload includes the requested fields of the GraphQL type UpdateRefPayload.
+// The GraphQL type's documentation follows.
+//
+// Autogenerated return type of UpdateRef
+type updateRefUpdateRefUpdateRefPayload struct {
+	// The updated Ref.
+	Ref updateRefUpdateRefUpdateRefPayloadRef `json:"ref"`
+}
+
+// GetRef returns updateRefUpdateRefUpdateRefPayload.Ref, and is useful for accessing the field via an interface.
+func (v *updateRefUpdateRefUpdateRefPayload) GetRef() updateRefUpdateRefUpdateRefPayloadRef {
+	return v.Ref
+}
+
+// updateRefUpdateRefUpdateRefPayloadRef includes the requested fields of the GraphQL type Ref.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git reference.
+type updateRefUpdateRefUpdateRefPayloadRef struct {
+	// The Node ID of the Ref object
+	Id string `json:"id"`
+}
+
+// GetId returns updateRefUpdateRefUpdateRefPayloadRef.Id, and is useful for accessing the field via an interface.
+func (v *updateRefUpdateRefUpdateRefPayloadRef) GetId() string { return v.Id }
+
+// The query or mutation executed by addPullRequestReview.
+const addPullRequestReview_Operation = `
+mutation addPullRequestReview ($pullRequestId: ID!, $event: PullRequestReviewEvent!, $body: String!) {
+	addPullRequestReview(input: {pullRequestId:$pullRequestId,event:$event,body:$body}) {
+		pullRequestReview {
+			id
+			state
+			submittedAt
+		}
+	}
+}
+`
+
+func addPullRequestReview(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+	event PullRequestReviewEvent,
+	body string,
+) (*addPullRequestReviewResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "addPullRequestReview",
+		Query:  addPullRequestReview_Operation,
+		Variables: &__addPullRequestReviewInput{
+			PullRequestId: pullRequestId,
+			Event:         event,
+			Body:          body,
+		},
+	}
+	var err_ error
+
+	var data_ addPullRequestReviewResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by closePullRequest.
+const closePullRequest_Operation = `
+mutation closePullRequest ($pullRequestId: ID!) {
+	closePullRequest(input: {pullRequestId:$pullRequestId}) {
+		pullRequest {
+			id
+			state
+		}
+	}
+}
+`
+
+func closePullRequest(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+) (*closePullRequestResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "closePullRequest",
+		Query:  closePullRequest_Operation,
+		Variables: &__closePullRequestInput{
+			PullRequestId: pullRequestId,
+		},
+	}
+	var err_ error
+
+	var data_ closePullRequestResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createCommitOnBranch.
+const createCommitOnBranch_Operation = `
+mutation createCommitOnBranch ($repositoryNameWithOwner: String!, $branchName: String!, $expectedHeadOid: GitObjectID!, $headline: String!, $body: String!, $path: String!, $contents: Base64String!) {
+	createCommitOnBranch(input: {branch:{repositoryNameWithOwner:$repositoryNameWithOwner,branchName:$branchName},expectedHeadOid:$expectedHeadOid,message:{headline:$headline,body:$body},fileChanges:{additions:[{path:$path,contents:$contents}]}}) {
+		commit {
+			oid
+			url
+		}
+	}
+}
+`
+
+func createCommitOnBranch(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repositoryNameWithOwner string,
+	branchName string,
+	expectedHeadOid string,
+	headline string,
+	body string,
+	path string,
+	contents string,
+) (*createCommitOnBranchResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createCommitOnBranch",
+		Query:  createCommitOnBranch_Operation,
+		Variables: &__createCommitOnBranchInput{
+			RepositoryNameWithOwner: repositoryNameWithOwner,
+			BranchName:              branchName,
+			ExpectedHeadOid:         expectedHeadOid,
+			Headline:                headline,
+			Body:                    body,
+			Path:                    path,
+			Contents:                contents,
+		},
+	}
+	var err_ error
+
+	var data_ createCommitOnBranchResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createDeployment.
+const createDeployment_Operation = `
+mutation createDeployment ($repositoryId: ID!, $refId: ID!, $environment: String!, $description: String!) {
+	createDeployment(input: {repositoryId:$repositoryId,refId:$refId,environment:$environment,description:$description,autoMerge:false,requiredContexts:[]}) {
+		deployment {
+			id
+			commitOid
+		}
+	}
+}
+`
+
+func createDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repositoryId string,
+	refId string,
+	environment string,
+	description string,
+) (*createDeploymentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createDeployment",
+		Query:  createDeployment_Operation,
+		Variables: &__createDeploymentInput{
+			RepositoryId: repositoryId,
+			RefId:        refId,
+			Environment:  environment,
+			Description:  description,
+		},
+	}
+	var err_ error
+
+	var data_ createDeploymentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createDeploymentStatus.
+const createDeploymentStatus_Operation = `
+mutation createDeploymentStatus ($deploymentId: ID!, $state: DeploymentStatusState!, $description: String!) {
+	createDeploymentStatus(input: {deploymentId:$deploymentId,state:$state,description:$description}) {
+		deploymentStatus {
+			id
+			state
+		}
+	}
+}
+`
+
+func createDeploymentStatus(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	deploymentId string,
+	state DeploymentStatusState,
+	description string,
+) (*createDeploymentStatusResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createDeploymentStatus",
+		Query:  createDeploymentStatus_Operation,
+		Variables: &__createDeploymentStatusInput{
+			DeploymentId: deploymentId,
+			State:        state,
+			Description:  description,
+		},
+	}
+	var err_ error
+
+	var data_ createDeploymentStatusResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createEnvironment.
+const createEnvironment_Operation = `
+mutation createEnvironment ($repositoryId: ID!, $name: String!) {
+	createEnvironment(input: {repositoryId:$repositoryId,name:$name}) {
+		environment {
+			id
+			name
+		}
+	}
+}
+`
+
+func createEnvironment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repositoryId string,
+	name string,
+) (*createEnvironmentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createEnvironment",
+		Query:  createEnvironment_Operation,
+		Variables: &__createEnvironmentInput{
+			RepositoryId: repositoryId,
+			Name:         name,
+		},
+	}
+	var err_ error
+
+	var data_ createEnvironmentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createIssue.
+const createIssue_Operation = `
+mutation createIssue ($Body: String!, $Title: String!, $RepositoryId: ID!) {
+	createIssue(input: {body:$Body,title:$Title,repositoryId:$RepositoryId}) {
+		issue {
+			id
+		}
+	}
+}
+`
+
+func createIssue(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	Body string,
+	Title string,
+	RepositoryId string,
+) (*createIssueResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createIssue",
+		Query:  createIssue_Operation,
+		Variables: &__createIssueInput{
+			Body:         Body,
+			Title:        Title,
+			RepositoryId: RepositoryId,
+		},
+	}
+	var err_ error
+
+	var data_ createIssueResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createPullRequest.
+const createPullRequest_Operation = `
+mutation createPullRequest ($BaseRefName: String!, $Body: String!, $HeadRefName: String!, $RepositoryId: ID!, $Title: String!) {
+	createPullRequest(input: {baseRefName:$BaseRefName,body:$Body,headRefName:$HeadRefName,repositoryId:$RepositoryId,title:$Title}) {
+		pullRequest {
+			id
+			number
+		}
+	}
+}
+`
+
+func createPullRequest(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	BaseRefName string,
+	Body string,
+	HeadRefName string,
+	RepositoryId string,
+	Title string,
+) (*createPullRequestResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createPullRequest",
+		Query:  createPullRequest_Operation,
+		Variables: &__createPullRequestInput{
+			BaseRefName:  BaseRefName,
+			Body:         Body,
+			HeadRefName:  HeadRefName,
+			RepositoryId: RepositoryId,
+			Title:        Title,
+		},
+	}
+	var err_ error
+
+	var data_ createPullRequestResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createRef.
+const createRef_Operation = `
+mutation createRef ($repositoryId: ID!, $name: String!, $oid: GitObjectID!) {
+	createRef(input: {repositoryId:$repositoryId,name:$name,oid:$oid}) {
+		ref {
+			id
+			name
+		}
+	}
+}
+`
+
+func createRef(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	repositoryId string,
+	name string,
+	oid string,
+) (*createRefResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createRef",
+		Query:  createRef_Operation,
+		Variables: &__createRefInput{
+			RepositoryId: repositoryId,
+			Name:         name,
+			Oid:          oid,
+		},
+	}
+	var err_ error
+
+	var data_ createRefResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by createRepository.
+const createRepository_Operation = `
+mutation createRepository ($ownerId: ID!, $name: String!, $description: String!, $visibility: RepositoryVisibility!) {
+	createRepository(input: {ownerId:$ownerId,name:$name,description:$description,visibility:$visibility}) {
+		repository {
+			id
+			url
+		}
+	}
+}
+`
+
+func createRepository(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	ownerId string,
+	name string,
+	description string,
+	visibility RepositoryVisibility,
+) (*createRepositoryResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createRepository",
+		Query:  createRepository_Operation,
+		Variables: &__createRepositoryInput{
+			OwnerId:     ownerId,
+			Name:        name,
+			Description: description,
+			Visibility:  visibility,
+		},
+	}
+	var err_ error
+
+	var data_ createRepositoryResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by deleteRef.
+const deleteRef_Operation = `
+mutation deleteRef ($refId: ID!) {
+	deleteRef(input: {refId:$refId}) {
+		clientMutationId
+	}
+}
+`
+
+func deleteRef(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	refId string,
+) (*deleteRefResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "deleteRef",
+		Query:  deleteRef_Operation,
+		Variables: &__deleteRefInput{
+			RefId: refId,
+		},
+	}
+	var err_ error
+
+	var data_ deleteRefResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by enablePullRequestAutoMerge.
+const enablePullRequestAutoMerge_Operation = `
+mutation enablePullRequestAutoMerge ($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod!) {
+	enablePullRequestAutoMerge(input: {pullRequestId:$pullRequestId,mergeMethod:$mergeMethod}) {
+		pullRequest {
+			id
+		}
+	}
+}
+`
+
+func enablePullRequestAutoMerge(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+	mergeMethod PullRequestMergeMethod,
+) (*enablePullRequestAutoMergeResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "enablePullRequestAutoMerge",
+		Query:  enablePullRequestAutoMerge_Operation,
+		Variables: &__enablePullRequestAutoMergeInput{
+			PullRequestId: pullRequestId,
+			MergeMethod:   mergeMethod,
+		},
+	}
+	var err_ error
+
+	var data_ enablePullRequestAutoMergeResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by enqueuePullRequest.
+const enqueuePullRequest_Operation = `
+mutation enqueuePullRequest ($pullRequestId: ID!) {
+	enqueuePullRequest(input: {pullRequestId:$pullRequestId}) {
+		mergeQueueEntry {
+			id
+			position
+		}
+	}
+}
+`
+
+func enqueuePullRequest(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+) (*enqueuePullRequestResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "enqueuePullRequest",
+		Query:  enqueuePullRequest_Operation,
+		Variables: &__enqueuePullRequestInput{
+			PullRequestId: pullRequestId,
+		},
+	}
+	var err_ error
+
+	var data_ enqueuePullRequestResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getCommitGitHubActionsRuns.
+const getCommitGitHubActionsRuns_Operation = `
+query getCommitGitHubActionsRuns ($owner: String!, $repo: String!, $commitSha: GitObjectID!, $cursor: String) {
+	repository(owner: $owner, name: $repo) {
+		object(oid: $commitSha) {
+			__typename
+			... on Commit {
+				statusCheckRollup {
+					contexts(first: 100, after: $cursor) {
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+						nodes {
+							__typename
+							... on CheckRun {
+								name
+								conclusion
+								status
+								detailsUrl
+								checkSuite {
+									workflowRun {
+										workflow {
+											name
+										}
+									}
+								}
+							}
+							... on StatusContext {
+								context
+								state
+								targetUrl
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func getCommitGitHubActionsRuns(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+	commitSha string,
+	cursor string,
+) (*getCommitGitHubActionsRunsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getCommitGitHubActionsRuns",
+		Query:  getCommitGitHubActionsRuns_Operation,
+		Variables: &__getCommitGitHubActionsRunsInput{
+			Owner:     owner,
+			Repo:      repo,
+			CommitSha: commitSha,
+			Cursor:    cursor,
+		},
+	}
+	var err_ error
+
+	var data_ getCommitGitHubActionsRunsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getCommitParent.
+const getCommitParent_Operation = `
+query getCommitParent ($owner: String!, $repo: String!, $oid: GitObjectID!) {
+	repository(owner: $owner, name: $repo) {
+		object(oid: $oid) {
+			__typename
+			... on Commit {
+				parents(first: 1) {
+					nodes {
+						oid
+					}
+				}
+			}
+		}
+	}
 }
+`
 
-// GetTheirName returns getUserUser.TheirName, and is useful for accessing the field via an interface.
-func (v *getUserUser) GetTheirName() string { return v.TheirName }
+func getCommitParent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+	oid string,
+) (*getCommitParentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getCommitParent",
+		Query:  getCommitParent_Operation,
+		Variables: &__getCommitParentInput{
+			Owner: owner,
+			Repo:  repo,
+			Oid:   oid,
+		},
+	}
+	var err_ error
 
-// GetCreatedAt returns getUserUser.CreatedAt, and is useful for accessing the field via an interface.
-func (v *getUserUser) GetCreatedAt() time.Time { return v.CreatedAt }
+	var data_ getCommitParentResponse
+	resp_ := &graphql.Response{Data: &data_}
 
-// getViewerResponse is returned by getViewer on success.
-type getViewerResponse struct {
-	// The currently authenticated user.
-	Viewer getViewerViewerUser `json:"viewer"`
-}
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// GetViewer returns getViewerResponse.Viewer, and is useful for accessing the field via an interface.
-func (v *getViewerResponse) GetViewer() getViewerViewerUser { return v.Viewer }
+	return &data_, err_
+}
 
-// getViewerViewerUser includes the requested fields of the GraphQL type User.
-// The GraphQL type's documentation follows.
-//
-// A user is an individual's account on GitHub that owns repositories and can make new content.
-type getViewerViewerUser struct {
-	// The user's public profile name.
-	MyName string `json:"MyName"`
-	// Identifies the date and time when the object was created.
-	CreatedAt time.Time `json:"createdAt"`
+// The query or mutation executed by getDefaultBranch.
+const getDefaultBranch_Operation = `
+query getDefaultBranch ($owner: String!, $repo: String!) {
+	repository(owner: $owner, name: $repo) {
+		id
+		defaultBranchRef {
+			name
+			target {
+				__typename
+				oid
+			}
+		}
+	}
 }
+`
 
-// GetMyName returns getViewerViewerUser.MyName, and is useful for accessing the field via an interface.
-func (v *getViewerViewerUser) GetMyName() string { return v.MyName }
+func getDefaultBranch(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+) (*getDefaultBranchResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getDefaultBranch",
+		Query:  getDefaultBranch_Operation,
+		Variables: &__getDefaultBranchInput{
+			Owner: owner,
+			Repo:  repo,
+		},
+	}
+	var err_ error
 
-// GetCreatedAt returns getViewerViewerUser.CreatedAt, and is useful for accessing the field via an interface.
-func (v *getViewerViewerUser) GetCreatedAt() time.Time { return v.CreatedAt }
+	var data_ getDefaultBranchResponse
+	resp_ := &graphql.Response{Data: &data_}
 
-// mergePullRequestMergePullRequestMergePullRequestPayload includes the requested fields of the GraphQL type MergePullRequestPayload.
-// The GraphQL type's documentation follows.
-//
-// Autogenerated return type of MergePullRequest
-type mergePullRequestMergePullRequestMergePullRequestPayload struct {
-	// The pull request that was merged.
-	PullRequest mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest `json:"pullRequest"`
-}
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// GetPullRequest returns mergePullRequestMergePullRequestMergePullRequestPayload.PullRequest, and is useful for accessing the field via an interface.
-func (v *mergePullRequestMergePullRequestMergePullRequestPayload) GetPullRequest() mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest {
-	return v.PullRequest
+	return &data_, err_
 }
 
-// mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest includes the requested fields of the GraphQL type PullRequest.
-// The GraphQL type's documentation follows.
-//
-// A repository pull request.
-type mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest struct {
-	// Whether or not the pull request was merged.
-	Merged bool `json:"merged"`
-	// The date and time that the pull request was merged.
-	MergedAt time.Time `json:"mergedAt"`
-	// The commit that was created when this pull request was merged.
-	MergeCommit mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit `json:"mergeCommit"`
+// The query or mutation executed by getGeneratedBranches.
+const getGeneratedBranches_Operation = `
+query getGeneratedBranches ($owner: String!, $repo: String!, $prefix: String!, $cursor: String) {
+	repository(owner: $owner, name: $repo) {
+		refs(refPrefix: "refs/heads/", query: $prefix, first: 100, after: $cursor) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				id
+				name
+				target {
+					__typename
+					... on Commit {
+						committedDate
+					}
+				}
+			}
+		}
+	}
 }
+`
 
-// GetMerged returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.Merged, and is useful for accessing the field via an interface.
-func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMerged() bool {
-	return v.Merged
-}
+func getGeneratedBranches(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+	prefix string,
+	cursor string,
+) (*getGeneratedBranchesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getGeneratedBranches",
+		Query:  getGeneratedBranches_Operation,
+		Variables: &__getGeneratedBranchesInput{
+			Owner:  owner,
+			Repo:   repo,
+			Prefix: prefix,
+			Cursor: cursor,
+		},
+	}
+	var err_ error
 
-// GetMergedAt returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.MergedAt, and is useful for accessing the field via an interface.
-func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMergedAt() time.Time {
-	return v.MergedAt
-}
+	var data_ getGeneratedBranchesResponse
+	resp_ := &graphql.Response{Data: &data_}
 
-// GetMergeCommit returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest.MergeCommit, and is useful for accessing the field via an interface.
-func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequest) GetMergeCommit() mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit {
-	return v.MergeCommit
-}
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit includes the requested fields of the GraphQL type Commit.
-// The GraphQL type's documentation follows.
-//
-// Represents a Git commit.
-type mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit struct {
-	// The Git object ID
-	Oid string `json:"oid"`
+	return &data_, err_
 }
 
-// GetOid returns mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit.Oid, and is useful for accessing the field via an interface.
-func (v *mergePullRequestMergePullRequestMergePullRequestPayloadPullRequestMergeCommit) GetOid() string {
-	return v.Oid
+// The query or mutation executed by getLatestDeployments.
+const getLatestDeployments_Operation = `
+query getLatestDeployments ($owner: String!, $repo: String!, $environments: [String!], $cursor: String) {
+	repository(owner: $owner, name: $repo) {
+		deployments(environments: $environments, orderBy: {field:CREATED_AT,direction:DESC}, first: 20, after: $cursor) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				createdAt
+				environment
+				commit {
+					oid
+					message
+					committedDate
+					associatedPullRequests(first: 1) {
+						nodes {
+							createdAt
+						}
+					}
+				}
+				description
+				state
+				statuses(first: 10) {
+					nodes {
+						updatedAt
+						state
+						environment
+					}
+				}
+			}
+		}
+	}
 }
+`
 
-// mergePullRequestResponse is returned by mergePullRequest on success.
-type mergePullRequestResponse struct {
-	// Merge a pull request.
-	MergePullRequest mergePullRequestMergePullRequestMergePullRequestPayload `json:"mergePullRequest"`
-}
+func getLatestDeployments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+	environments []string,
+	cursor string,
+) (*getLatestDeploymentsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getLatestDeployments",
+		Query:  getLatestDeployments_Operation,
+		Variables: &__getLatestDeploymentsInput{
+			Owner:        owner,
+			Repo:         repo,
+			Environments: environments,
+			Cursor:       cursor,
+		},
+	}
+	var err_ error
 
-// GetMergePullRequest returns mergePullRequestResponse.MergePullRequest, and is useful for accessing the field via an interface.
-func (v *mergePullRequestResponse) GetMergePullRequest() mergePullRequestMergePullRequestMergePullRequestPayload {
-	return v.MergePullRequest
+	var data_ getLatestDeploymentsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
 }
 
-// The query or mutation executed by createIssue.
-const createIssue_Operation = `
-mutation createIssue ($Body: String!, $Title: String!, $RepositoryId: ID!) {
-	createIssue(input: {body:$Body,title:$Title,repositoryId:$RepositoryId}) {
-		issue {
-			id
+// The query or mutation executed by getOpenPullRequests.
+const getOpenPullRequests_Operation = `
+query getOpenPullRequests ($owner: String!, $repo: String!, $cursor: String) {
+	repository(owner: $owner, name: $repo) {
+		pullRequests(states: OPEN, first: 100, after: $cursor) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				id
+				number
+				createdAt
+				headRefName
+				body
+				labels(first: 20) {
+					nodes {
+						name
+					}
+				}
+			}
 		}
 	}
 }
 `
 
-func createIssue(
+func getOpenPullRequests(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	Body string,
-	Title string,
-	RepositoryId string,
-) (*createIssueResponse, error) {
+	owner string,
+	repo string,
+	cursor string,
+) (*getOpenPullRequestsResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "createIssue",
-		Query:  createIssue_Operation,
-		Variables: &__createIssueInput{
-			Body:         Body,
-			Title:        Title,
-			RepositoryId: RepositoryId,
+		OpName: "getOpenPullRequests",
+		Query:  getOpenPullRequests_Operation,
+		Variables: &__getOpenPullRequestsInput{
+			Owner:  owner,
+			Repo:   repo,
+			Cursor: cursor,
 		},
 	}
 	var err_ error
 
-	var data_ createIssueResponse
+	var data_ getOpenPullRequestsResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1147,41 +5389,42 @@ func createIssue(
 	return &data_, err_
 }
 
-// The query or mutation executed by createPullRequest.
-const createPullRequest_Operation = `
-mutation createPullRequest ($BaseRefName: String!, $Body: String!, $HeadRefName: String!, $RepositoryId: ID!, $Title: String!) {
-	createPullRequest(input: {baseRefName:$BaseRefName,body:$Body,headRefName:$HeadRefName,repositoryId:$RepositoryId,title:$Title}) {
-		pullRequest {
+// The query or mutation executed by getPullRequestHead.
+const getPullRequestHead_Operation = `
+query getPullRequestHead ($owner: String!, $repo: String!, $prNumber: Int!) {
+	repository(owner: $owner, name: $repo) {
+		pullRequest(number: $prNumber) {
 			id
-			number
+			body
+			headRefName
+			headRefOid
+			headRef {
+				id
+			}
 		}
 	}
 }
 `
 
-func createPullRequest(
+func getPullRequestHead(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	BaseRefName string,
-	Body string,
-	HeadRefName string,
-	RepositoryId string,
-	Title string,
-) (*createPullRequestResponse, error) {
+	owner string,
+	repo string,
+	prNumber int,
+) (*getPullRequestHeadResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "createPullRequest",
-		Query:  createPullRequest_Operation,
-		Variables: &__createPullRequestInput{
-			BaseRefName:  BaseRefName,
-			Body:         Body,
-			HeadRefName:  HeadRefName,
-			RepositoryId: RepositoryId,
-			Title:        Title,
+		OpName: "getPullRequestHead",
+		Query:  getPullRequestHead_Operation,
+		Variables: &__getPullRequestHeadInput{
+			Owner:    owner,
+			Repo:     repo,
+			PrNumber: prNumber,
 		},
 	}
 	var err_ error
 
-	var data_ createPullRequestResponse
+	var data_ getPullRequestHeadResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1193,51 +5436,46 @@ func createPullRequest(
 	return &data_, err_
 }
 
-// The query or mutation executed by getCommitGitHubActionsRuns.
-const getCommitGitHubActionsRuns_Operation = `
-query getCommitGitHubActionsRuns ($owner: String!, $repo: String!, $commitSha: GitObjectID!) {
+// The query or mutation executed by getPullRequestMergeState.
+const getPullRequestMergeState_Operation = `
+query getPullRequestMergeState ($owner: String!, $repo: String!, $prNumber: Int!) {
 	repository(owner: $owner, name: $repo) {
-		object(oid: $commitSha) {
-			__typename
-			... on Commit {
-				statusCheckRollup {
-					contexts(last: 10) {
-						nodes {
-							__typename
-							... on CheckRun {
-								name
-								conclusion
-								status
-								detailsUrl
-							}
-						}
-					}
-				}
+		pullRequest(number: $prNumber) {
+			state
+			merged
+			mergedAt
+			mergeStateStatus
+			isInMergeQueue
+			statusCheckRollup {
+				state
+			}
+			mergeCommit {
+				oid
 			}
 		}
 	}
 }
 `
 
-func getCommitGitHubActionsRuns(
+func getPullRequestMergeState(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	owner string,
 	repo string,
-	commitSha string,
-) (*getCommitGitHubActionsRunsResponse, error) {
+	prNumber int,
+) (*getPullRequestMergeStateResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getCommitGitHubActionsRuns",
-		Query:  getCommitGitHubActionsRuns_Operation,
-		Variables: &__getCommitGitHubActionsRunsInput{
-			Owner:     owner,
-			Repo:      repo,
-			CommitSha: commitSha,
+		OpName: "getPullRequestMergeState",
+		Query:  getPullRequestMergeState_Operation,
+		Variables: &__getPullRequestMergeStateInput{
+			Owner:    owner,
+			Repo:     repo,
+			PrNumber: prNumber,
 		},
 	}
 	var err_ error
 
-	var data_ getCommitGitHubActionsRunsResponse
+	var data_ getPullRequestMergeStateResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1249,24 +5487,23 @@ func getCommitGitHubActionsRuns(
 	return &data_, err_
 }
 
-// The query or mutation executed by getLatestDeployments.
-const getLatestDeployments_Operation = `
-query getLatestDeployments ($owner: String!, $repo: String!) {
+// The query or mutation executed by getPullRequestMergeability.
+const getPullRequestMergeability_Operation = `
+query getPullRequestMergeability ($owner: String!, $repo: String!, $prNumber: Int!) {
 	repository(owner: $owner, name: $repo) {
-		deployments(last: 10) {
-			nodes {
-				createdAt
-				commit {
-					message
-				}
-				description
-				state
-				statuses(first: 10) {
-					nodes {
-						updatedAt
-						state
-						environment
-					}
+		pullRequest(number: $prNumber) {
+			id
+			mergeable
+			mergeStateStatus
+			headRefName
+			headRefOid
+			headRef {
+				id
+			}
+			baseRef {
+				target {
+					__typename
+					oid
 				}
 			}
 		}
@@ -1274,23 +5511,25 @@ query getLatestDeployments ($owner: String!, $repo: String!) {
 }
 `
 
-func getLatestDeployments(
+func getPullRequestMergeability(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	owner string,
 	repo string,
-) (*getLatestDeploymentsResponse, error) {
+	prNumber int,
+) (*getPullRequestMergeabilityResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "getLatestDeployments",
-		Query:  getLatestDeployments_Operation,
-		Variables: &__getLatestDeploymentsInput{
-			Owner: owner,
-			Repo:  repo,
+		OpName: "getPullRequestMergeability",
+		Query:  getPullRequestMergeability_Operation,
+		Variables: &__getPullRequestMergeabilityInput{
+			Owner:    owner,
+			Repo:     repo,
+			PrNumber: prNumber,
 		},
 	}
 	var err_ error
 
-	var data_ getLatestDeploymentsResponse
+	var data_ getPullRequestMergeabilityResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -1307,6 +5546,7 @@ const getPullRequestStatusCheckRollup_Operation = `
 query getPullRequestStatusCheckRollup ($owner: String!, $repo: String!, $prNumber: Int!) {
 	repository(owner: $owner, name: $repo) {
 		pullRequest(number: $prNumber) {
+			headRefOid
 			statusCheckRollup {
 				state
 			}
@@ -1382,6 +5622,95 @@ func getRepoId(
 	return &data_, err_
 }
 
+// The query or mutation executed by getRepositoryFile.
+const getRepositoryFile_Operation = `
+query getRepositoryFile ($owner: String!, $repo: String!, $expression: String!) {
+	repository(owner: $owner, name: $repo) {
+		object(expression: $expression) {
+			__typename
+			... on Blob {
+				text
+				isBinary
+			}
+		}
+	}
+}
+`
+
+// getRepositoryFile reads a file through a `<rev>:<path>` expression
+func getRepositoryFile(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+	expression string,
+) (*getRepositoryFileResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getRepositoryFile",
+		Query:  getRepositoryFile_Operation,
+		Variables: &__getRepositoryFileInput{
+			Owner:      owner,
+			Repo:       repo,
+			Expression: expression,
+		},
+	}
+	var err_ error
+
+	var data_ getRepositoryFileResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by getRepositoryOwner.
+const getRepositoryOwner_Operation = `
+query getRepositoryOwner ($owner: String!, $repo: String!) {
+	repositoryOwner(login: $owner) {
+		__typename
+		id
+		repository(name: $repo) {
+			id
+			isEmpty
+		}
+	}
+}
+`
+
+// getRepositoryOwner looks up the owner and, if it exists, the named repository
+func getRepositoryOwner(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	owner string,
+	repo string,
+) (*getRepositoryOwnerResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getRepositoryOwner",
+		Query:  getRepositoryOwner_Operation,
+		Variables: &__getRepositoryOwnerInput{
+			Owner: owner,
+			Repo:  repo,
+		},
+	}
+	var err_ error
+
+	var data_ getRepositoryOwnerResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getUser.
 const getUser_Operation = `
 query getUser ($Login: String!) {
@@ -1451,10 +5780,42 @@ func getViewer(
 	return &data_, err_
 }
 
+// The query or mutation executed by getViewerIdentity.
+const getViewerIdentity_Operation = `
+query getViewerIdentity {
+	viewer {
+		id
+		login
+	}
+}
+`
+
+func getViewerIdentity(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*getViewerIdentityResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "getViewerIdentity",
+		Query:  getViewerIdentity_Operation,
+	}
+	var err_ error
+
+	var data_ getViewerIdentityResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by mergePullRequest.
 const mergePullRequest_Operation = `
-mutation mergePullRequest ($pullRequestId: ID!) {
-	mergePullRequest(input: {pullRequestId:$pullRequestId,mergeMethod:SQUASH}) {
+mutation mergePullRequest ($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod!) {
+	mergePullRequest(input: {pullRequestId:$pullRequestId,mergeMethod:$mergeMethod}) {
 		pullRequest {
 			merged
 			mergedAt
@@ -1466,16 +5827,20 @@ mutation mergePullRequest ($pullRequestId: ID!) {
 }
 `
 
+// baseRef is the head of the base branch right after the merge. For rebase
+// merges that is the commit the deploy runs against.
 func mergePullRequest(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	pullRequestId string,
+	mergeMethod PullRequestMergeMethod,
 ) (*mergePullRequestResponse, error) {
 	req_ := &graphql.Request{
 		OpName: "mergePullRequest",
 		Query:  mergePullRequest_Operation,
 		Variables: &__mergePullRequestInput{
 			PullRequestId: pullRequestId,
+			MergeMethod:   mergeMethod,
 		},
 	}
 	var err_ error
@@ -1491,3 +5856,163 @@ func mergePullRequest(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by requestReviews.
+const requestReviews_Operation = `
+mutation requestReviews ($pullRequestId: ID!, $userIds: [ID!]!) {
+	requestReviews(input: {pullRequestId:$pullRequestId,userIds:$userIds,union:true}) {
+		pullRequest {
+			id
+		}
+	}
+}
+`
+
+func requestReviews(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+	userIds []string,
+) (*requestReviewsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "requestReviews",
+		Query:  requestReviews_Operation,
+		Variables: &__requestReviewsInput{
+			PullRequestId: pullRequestId,
+			UserIds:       userIds,
+		},
+	}
+	var err_ error
+
+	var data_ requestReviewsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by updatePullRequestBody.
+const updatePullRequestBody_Operation = `
+mutation updatePullRequestBody ($pullRequestId: ID!, $body: String!) {
+	updatePullRequest(input: {pullRequestId:$pullRequestId,body:$body}) {
+		pullRequest {
+			id
+		}
+	}
+}
+`
+
+func updatePullRequestBody(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+	body string,
+) (*updatePullRequestBodyResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "updatePullRequestBody",
+		Query:  updatePullRequestBody_Operation,
+		Variables: &__updatePullRequestBodyInput{
+			PullRequestId: pullRequestId,
+			Body:          body,
+		},
+	}
+	var err_ error
+
+	var data_ updatePullRequestBodyResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by updatePullRequestBranch.
+const updatePullRequestBranch_Operation = `
+mutation updatePullRequestBranch ($pullRequestId: ID!, $expectedHeadOid: GitObjectID!, $updateMethod: PullRequestBranchUpdateMethod!) {
+	updatePullRequestBranch(input: {pullRequestId:$pullRequestId,expectedHeadOid:$expectedHeadOid,updateMethod:$updateMethod}) {
+		pullRequest {
+			headRefOid
+		}
+	}
+}
+`
+
+func updatePullRequestBranch(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	pullRequestId string,
+	expectedHeadOid string,
+	updateMethod PullRequestBranchUpdateMethod,
+) (*updatePullRequestBranchResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "updatePullRequestBranch",
+		Query:  updatePullRequestBranch_Operation,
+		Variables: &__updatePullRequestBranchInput{
+			PullRequestId:   pullRequestId,
+			ExpectedHeadOid: expectedHeadOid,
+			UpdateMethod:    updateMethod,
+		},
+	}
+	var err_ error
+
+	var data_ updatePullRequestBranchResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by updateRef.
+const updateRef_Operation = `
+mutation updateRef ($refId: ID!, $oid: GitObjectID!, $force: Boolean!) {
+	updateRef(input: {refId:$refId,oid:$oid,force:$force}) {
+		ref {
+			id
+		}
+	}
+}
+`
+
+func updateRef(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	refId string,
+	oid string,
+	force bool,
+) (*updateRefResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "updateRef",
+		Query:  updateRef_Operation,
+		Variables: &__updateRefInput{
+			RefId: refId,
+			Oid:   oid,
+			Force: force,
+		},
+	}
+	var err_ error
+
+	var data_ updateRefResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,7 +10,6 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"strconv"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -17,7 +17,7 @@ import (
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +25,7 @@ var (
 	hclPath              = "envs/dev/terragrunt.hcl"
 	reExpression         = `(github\.com/liatrio/dora-lambda-tf-module-demo\?ref=)v\d+\.\d+\.\d+`
 	upToDateReExpression = `(github\.com/liatrio/dora-lambda-tf-module-demo\?ref=)v0.6.2`
+	versionReExpression  = `github\.com/liatrio/dora-lambda-tf-module-demo\?ref=(v\d+\.\d+\.\d+)`
 )
 
 type authedTransport struct {
@@ -38,27 +39,71 @@ func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 }
 
 type GitHubRepoContext struct {
-	gitHubDomain  string
-	pat           string
-	client        graphql.Client
-	name          string
-	org           string
-	remoteRepoUrl string
-	logger        *zap.Logger
-	// localDir      string
-	// repo          *git.Repository
+	gitHubDomain        string
+	pat                 string
+	client              graphql.Client
+	name                string
+	org                 string
+	remoteRepoUrl       string
+	logger              *zap.Logger
+	templates           *ChangeTemplates
+	personas            *PersonaPool
+	reviewers           []*Persona // Reviewers in addition to the personas with tokens
+	graphqlUrl          string
+	localDir            string // Persistent working copy, a temp clone is used when empty
+	shallowClone        bool
+	changeMode          string // ChangeModeGit or ChangeModeAPI
+	mergeMode           string // MergeModeDirect, MergeModeAuto or MergeModeQueue
+	deleteHeadBranch    bool
+	checkFailurePolicy  string // CheckFailurePolicyClose, CheckFailurePolicyLeave or CheckFailurePolicyRetry
+	checkFailureRetries int
+	history             *History
+	updateBranchMethod  PullRequestBranchUpdateMethod
+	deployMode          string            // DeployModeWorkflow or DeployModeAPI
+	recoveryStrategy    string            // RecoveryStrategyForward or RecoveryStrategyRollback
+	goodDeployments     map[string]string // Last commit successfully deployed to each environment
+	openIncidents       map[string]Event  // Incident opened by the last failed deployment to each environment
+	cadenceFilter       DeploymentFilter  // Deployments that count towards the deployment cadence
+	deployChecks        []DeployCheck     // Checks that complete a deployment in DeployModeWorkflow
+	deployCheckGrace    time.Duration     // How long to wait for the deploy checks to show up
+	statusCheckGrace    time.Duration     // How long to wait for status checks to show up on a new head commit
+	webhooks            *WebhookReceiver  // Wakes waiters early, nil when webhooks are disabled
+	metrics             *SimulatorMetrics // Prometheus metrics, nil when not collected
+	status              *StatusTracker    // Backs the health and status endpoints, nil when not served
+	cicdEvents          *CICDEventEmitter // Adds events to the change cycle span, nil when disabled
 }
 
 func (ghc *GitHubRepoContext) generateClient(url string) graphql.Client {
+	return newGraphQLClient(url, ghc.pat)
+}
+
+func newGraphQLClient(url string, token string) graphql.Client {
 	httpClient := http.Client{
 		Transport: &authedTransport{
-			key:     ghc.pat,
+			key:     token,
 			wrapped: http.DefaultTransport,
 		},
 	}
 	return graphql.NewClient(url, &httpClient)
 }
 
+// Returns the token to act as the given persona, falling back to the PAT when
+// the persona has no token of their own.
+func (ghc *GitHubRepoContext) tokenFor(persona *Persona) string {
+	if persona != nil && persona.Token != "" {
+		return persona.Token
+	}
+	return ghc.pat
+}
+
+// Returns a GraphQL client that acts as the given persona
+func (ghc *GitHubRepoContext) clientFor(persona *Persona) graphql.Client {
+	if persona == nil || persona.Token == "" {
+		return ghc.client
+	}
+	return newGraphQLClient(ghc.graphqlUrl, persona.Token)
+}
+
 func (ghc *GitHubRepoContext) CalculateRepoUrl() (string, error) {
 	url, err := url.JoinPath(ghc.gitHubDomain, ghc.org, ghc.name)
 	if err != nil {
@@ -67,225 +112,429 @@ func (ghc *GitHubRepoContext) CalculateRepoUrl() (string, error) {
 	return url + ".git", nil
 }
 
-// If no deployments exist, return nil
-func (ghrc *GitHubRepoContext) GetLastDeployment(ctx context.Context) (*getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeployment, error) {
-	recentDeployments, err := getLatestDeployments(ctx, ghrc.client, ghrc.org, ghrc.name)
-	if err != nil {
-		logger.Sugar().Errorf("Error getting latest deployments for %s/%s: %s", ghrc.org, ghrc.name, err)
-		return nil, err
+// Returns the most recently created deployment matching the filter, paging
+// through older deployments as needed. If no deployments match, return nil
+func (ghrc *GitHubRepoContext) GetLastDeployment(ctx context.Context, filter DeploymentFilter) (*LastDeployment, error) {
+	var environments []string
+	if filter.Environment != "" {
+		environments = []string{filter.Environment}
 	}
 
-	if len(recentDeployments.Repository.Deployments.Nodes) == 0 {
-		logger.Sugar().Infof("No deployments found for %s/%s", ghrc.org, ghrc.name)
-		return nil, nil
+	var cursor string
+	for {
+		recentDeployments, err := getLatestDeployments(ctx, ghrc.client, ghrc.org, ghrc.name, environments, cursor)
+		if err != nil {
+			ghrc.logger.Sugar().Errorf("Error getting latest deployments for %s/%s: %s", ghrc.org, ghrc.name, err)
+			return nil, err
+		}
+
+		for i := range recentDeployments.Repository.Deployments.Nodes {
+			deployment := &recentDeployments.Repository.Deployments.Nodes[i]
+			if deployedAt, ok := filter.deployedAt(deployment); ok {
+				return &LastDeployment{Deployment: deployment, DeployedAt: deployedAt}, nil
+			}
+		}
+
+		if !recentDeployments.Repository.Deployments.PageInfo.HasNextPage {
+			break
+		}
+		cursor = recentDeployments.Repository.Deployments.PageInfo.EndCursor
 	}
 
-	// return the last deployment
-	return &recentDeployments.Repository.Deployments.Nodes[len(recentDeployments.Repository.Deployments.Nodes)-1], nil
+	ghrc.logger.Sugar().Infof("No deployments found for %s/%s", ghrc.org, ghrc.name)
+	return nil, nil
 }
 
-// This function will wait for up to 10 minutes for the deployment to complete
-func (ghrc *GitHubRepoContext) WaitForDeployment(ctx context.Context, sha string) error {
-	logger.Sugar().Infof("Waiting for Deploy workflow to complete for %s", sha)
+// This function will wait for up to 10 minutes for the deployment to complete,
+// that is for every required deploy check on the commit to succeed. Returns an
+// error wrapping ErrDeployCheckNotFound when a check has not shown up within
+// the grace period.
+func (ghrc *GitHubRepoContext) WaitForDeployment(ctx context.Context, sha string) (err error) {
+	ctx, span := tracer.Start(ctx, "wait for deployment", trace.WithAttributes(attrSHA.String(sha)))
+	defer func() { endSpan(span, err) }()
+
+	ghrc.logger.Sugar().Infof("Waiting for deploy checks %v to complete for %s", ghrc.deployChecks, sha)
 	timeout := time.After(10 * time.Minute)
+	grace := time.Now().Add(ghrc.deployCheckGrace)
 	tick := time.Tick(10 * time.Second)
+	wake, unsubscribe := ghrc.webhooks.Subscribe(shaKey(sha))
+	defer unsubscribe()
 
 	for {
 		select {
 		case <-timeout:
 			return errors.New("Timed out after 10 minutes waiting for deployment")
 		case <-tick:
-			commitGitHubActionRuns, err := getCommitGitHubActionsRuns(ctx, ghrc.client, ghrc.org, ghrc.name, sha)
-			if err != nil {
-				return err
-			}
+		case <-wake:
+		}
+		ghrc.status.Heartbeat()
 
-			if commit, ok := commitGitHubActionRuns.Repository.Object.(*getCommitGitHubActionsRunsRepositoryObjectCommit); ok {
-				for _, node := range commit.StatusCheckRollup.Contexts.Nodes {
-					if cr, ok := node.(*GitHubActionCheckRun); ok {
-						if cr.Name != "deploy" {
-							continue
-						}
-						switch cr.Status {
-						case "COMPLETED":
-							if cr.Conclusion == "SUCCESS" {
-								return nil
-							} else {
-								return errors.New("Deployment failed")
-							}
-						case "IN_PROGRESS":
-							continue
-						case "QUEUED":
-							continue
-						case "REQUESTED":
-							continue
-						default:
-							return fmt.Errorf("Unknown deployment state: %s", cr.Status)
-						}
-					}
-				}
-			} else {
-				return errors.New("Error getting commit")
-			}
+		nodes, err := ghrc.getCommitContexts(ctx, sha)
+		if err != nil {
+			return err
+		}
 
-			// switch deploymentAction {
-			// // case "SUCCESS":
-			// // 	return nil
-			// // case "FAILURE":
-			// // 	return errors.New("Deployment failed")
-			// // case "PENDING":
-			// // 	continue
-			// // case "ERROR":
-			// // 	return errors.New("Deployment errored")
-			// // case "EXPECTED":
-			// // 	continue
-			// default:
-			// 	return fmt.Errorf("Unknown deployment state: %s", deploymentAction)
-			// }
+		done, missing, err := evaluateDeployChecks(ghrc.deployChecks, nodes)
+		if err != nil || done {
+			return err
+		}
+		if len(missing) > 0 && time.Now().After(grace) {
+			return fmt.Errorf("%v after %s: %w", missing, ghrc.deployCheckGrace, ErrDeployCheckNotFound)
 		}
 	}
 }
 
+// Returns all check runs and commit statuses on the commit
+func (ghrc *GitHubRepoContext) getCommitContexts(ctx context.Context, sha string) ([]GitHubAction, error) {
+	var nodes []GitHubAction
+	var cursor string
+	for {
+		commitGitHubActionRuns, err := getCommitGitHubActionsRuns(ctx, ghrc.client, ghrc.org, ghrc.name, sha, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		commit, ok := commitGitHubActionRuns.Repository.Object.(*getCommitGitHubActionsRunsRepositoryObjectCommit)
+		if !ok {
+			return nil, errors.New("Error getting commit")
+		}
+		contexts := commit.StatusCheckRollup.Contexts
+		nodes = append(nodes, contexts.Nodes...)
+
+		if !contexts.PageInfo.HasNextPage {
+			return nodes, nil
+		}
+		cursor = contexts.PageInfo.EndCursor
+	}
+}
+
 // This function will generate a deployment for the given repository
-// Each call it will clone the remote repository to a temp directory.
+// Each call it will clone the remote repository to a temp directory, or refresh
+// the persistent working copy when one is configured.
 // It will create a  branch, make a change, commit the change, and push the
 // branch to the remote. Create a Pull Request and Merge it.
+// In ChangeModeAPI the branch and commit are created through GraphQL instead
+// and nothing is cloned.
 // Workflows will then run to create a Deployment.
-func (ghrc *GitHubRepoContext) GeneratePullRequest(ctx context.Context, logger *zap.Logger) (prId *createPullRequestResponse, err error) {
-	// Create a temp directory and clone the repository
-	dir, err := os.MkdirTemp("", "cloned-repo")
-	if err != nil {
-		logger.Sugar().Errorf("Error creating temp dir: %s", err)
-		return
-	}
-	logger.Sugar().Infof("Temp dir is: %v", dir)
+func (ghrc *GitHubRepoContext) GeneratePullRequest(ctx context.Context, logger *zap.Logger, data *ChangeTemplateData, persona *Persona) (prId *createPullRequestResponse, err error) {
+	var change *RenderedChange
+	var baseRefName, repoId string
+
+	switch ghrc.changeMode {
+	case ChangeModeAPI:
+		genCtx, span := tracer.Start(ctx, "generate change")
+		change, baseRefName, repoId, err = ghrc.GenerateChangeAPIBranch(genCtx, data, persona, logger)
+		endSpan(span, err)
+		if err != nil {
+			logger.Sugar().Errorf("Error generating change: %s", err)
+			return
+		}
+	default:
+		var wc *WorkingCopy
+		cloneCtx, span := tracer.Start(ctx, "clone")
+		wc, err = ghrc.PrepareWorkingCopy(cloneCtx, logger)
+		endSpan(span, err)
+		if err != nil {
+			return
+		}
+		defer wc.Close()
 
-	defer os.RemoveAll(dir) // clean up
+		baseRefName = wc.BaseRefName
 
-	// Clones the repository into the given dir, just as a normal git clone does
-	repo, err := git.PlainClone(dir, false, &git.CloneOptions{
-		URL: ghrc.remoteRepoUrl,
-		Auth: &githttp.BasicAuth{
-			Username: "dora-the-explorer",
-			Password: ghrc.pat,
-		},
-	})
+		// Generate a remote branch with a change to the repo
+		genCtx, span := tracer.Start(ctx, "generate change")
+		change, err = GenerateChangeRemoteBranch(genCtx, wc.Dir, ghrc, wc.Repo, data, persona, logger)
+		endSpan(span, err)
+		if err != nil {
+			logger.Sugar().Errorf("Error generating change: %s", err)
+			return
+		}
 
-	if err != nil {
-		logger.Sugar().Errorf("Error cloning repository: %s", err)
-		return
+		var repoIdResp *getRepoIdResponse
+		repoIdResp, err = getRepoId(ctx, ghrc.client, ghrc.org, ghrc.name)
+		if err != nil {
+			logger.Sugar().Errorf("Error getting repo ID: %s", err)
+			return
+		}
+		repoId = repoIdResp.Repository.Id
+	}
+
+	ghrc.history.Record(Event{Type: EventBranchPushed, SHA: change.HeadSHA, HeadRef: change.BranchName, BaseRef: baseRefName, Detail: change.BranchName})
+
+	// Create a Pull Request
+	prCtx, span := tracer.Start(ctx, "create pull request")
+	prId, err = createPullRequest(prCtx,
+		ghrc.clientFor(persona),
+		baseRefName,
+		change.PRBody,
+		change.BranchName,
+		repoId,
+		change.PRTitle)
+	if err == nil {
+		span.SetAttributes(attrPRNumber.Int(prId.CreatePullRequest.PullRequest.Number))
 	}
-	head, err := repo.Head()
+	endSpan(span, err)
 	if err != nil {
-		logger.Sugar().Errorf("Error getting HEAD: %s", err)
+		logger.Sugar().Errorf("Error creating PR: %s", err)
 		return
 	}
 
-	baseRefName := head.Name().Short()
+	logger.Sugar().Infof("Created PR: %d as %s", prId.CreatePullRequest.PullRequest.Number, persona.Name)
+	ghrc.history.Record(Event{
+		Type:     EventPROpened,
+		PRNumber: prId.CreatePullRequest.PullRequest.Number,
+		SHA:      change.HeadSHA,
+		HeadRef:  change.BranchName,
+		BaseRef:  baseRefName,
+		Detail:   persona.Name,
+	})
 
-	// Generate a remote branch with a change to the repo
-	branchName, err := GenerateChangeRemoteBranch(dir, ghrc, repo, logger)
+	return prId, err
+}
 
-	// Create a Pull Request
-	repoIdResp, err := getRepoId(ctx, ghrc.client, ghrc.org, ghrc.name)
+// Brings a pull request up to date with its base branch when it has fallen
+// behind or conflicts with it. Returns true if the head branch was updated, in
+// which case the status checks run again.
+//
+// A pull request that is BEHIND is updated with updatePullRequestBranch. One
+// that is CONFLICTING is rebuilt: the change is re-applied to the target file
+// on the current base head in a temporary branch, and the head branch is force
+// updated to the result.
+func (ghrc *GitHubRepoContext) UpdateBaseBranch(
+	ctx context.Context,
+	logger *zap.Logger,
+	prNumber int,
+	author *Persona,
+	data *ChangeTemplateData) (bool, error) {
+
+	pr, err := ghrc.waitForMergeability(ctx, prNumber)
 	if err != nil {
-		logger.Sugar().Errorf("Error getting repo ID: %s", err)
-		return
+		return false, err
 	}
 
-	prId, err = createPullRequest(ctx,
-		ghrc.client,
-		baseRefName,
-		"Generated by Dora the Explorer",
-		branchName,
-		repoIdResp.Repository.Id,
-		"fix: Change app version")
+	switch {
+	case pr.Mergeable == MergeableStateConflicting || pr.MergeStateStatus == MergeStateStatusDirty:
+		logger.Sugar().Infof("PR %d conflicts with its base branch, re-applying the change", prNumber)
+		if pr.BaseRef.Target == nil {
+			return false, fmt.Errorf("PR %d has no base branch", prNumber)
+		}
+		sha, err := ghrc.reapplyChange(ctx, pr.HeadRef.Id, pr.BaseRef.Target.GetOid(), author, data)
+		if err != nil {
+			return false, fmt.Errorf("Error re-applying change to PR %d: %s", prNumber, err)
+		}
+		logger.Sugar().Infof("Rebuilt PR %d on top of its base branch as %s", prNumber, sha)
+		return true, nil
+	case pr.MergeStateStatus == MergeStateStatusBehind:
+		logger.Sugar().Infof("PR %d is behind its base branch, updating", prNumber)
+		_, err := updatePullRequestBranch(ctx, ghrc.clientFor(author), pr.Id, pr.HeadRefOid, ghrc.updateBranchMethod)
+		if err != nil {
+			return false, fmt.Errorf("Error updating PR %d: %s", prNumber, err)
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
 
-	logger.Sugar().Infof("Created PR: %d", prId.CreatePullRequest.PullRequest.Number)
+// GitHub computes mergeability in the background, poll for up to a minute
+// until it is known
+func (ghrc *GitHubRepoContext) waitForMergeability(ctx context.Context, prNumber int) (*getPullRequestMergeabilityRepositoryPullRequest, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := getPullRequestMergeability(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting mergeability of PR %d: %s", prNumber, err)
+		}
 
-	return prId, err
+		pr := &resp.Repository.PullRequest
+		if pr.Mergeable != MergeableStateUnknown && pr.MergeStateStatus != MergeStateStatusUnknown {
+			return pr, nil
+		}
+		if attempt >= 6 {
+			return pr, nil
+		}
+		if err := ghrc.sleep(ctx, 10*time.Second); err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (ghrc *GitHubRepoContext) UpdateBaseBranch() {
+// Re-applies the change on top of baseOid and force updates the head ref to
+// it. The commit is built on a temporary branch so the head ref moves straight
+// from the old change to the new one.
+func (ghrc *GitHubRepoContext) reapplyChange(ctx context.Context, headRefId string, baseOid string, author *Persona, data *ChangeTemplateData) (string, error) {
+	file, err := getRepositoryFile(ctx, ghrc.client, ghrc.org, ghrc.name, baseOid+":"+hclPath)
+	if err != nil {
+		return "", fmt.Errorf("Error reading %s: %s", hclPath, err)
+	}
+	blob, ok := file.Repository.Object.(*getRepositoryFileRepositoryObjectBlob)
+	if !ok || blob.IsBinary {
+		return "", fmt.Errorf("%s not found at %s", hclPath, baseOid)
+	}
 
+	repoId, err := getRepoId(ctx, ghrc.client, ghrc.org, ghrc.name)
+	if err != nil {
+		return "", err
+	}
+
+	client := ghrc.clientFor(author)
+	tempBranch := fmt.Sprintf("%sreapply-%d", ghrc.templates.BranchPrefix, time.Now().UnixMilli())
+	tempRef, err := createRef(ctx, client, repoId.Repository.Id, "refs/heads/"+tempBranch, baseOid)
+	if err != nil {
+		return "", fmt.Errorf("Error creating %s: %s", tempBranch, err)
+	}
+	defer func() {
+		if _, err := deleteRef(ctx, client, tempRef.CreateRef.Ref.Id); err != nil {
+			ghrc.logger.Sugar().Errorf("Error deleting %s: %s", tempBranch, err)
+		}
+	}()
+
+	headline := fmt.Sprintf("Re-apply %s %s -> %s", data.ChangeType, data.FromVersion, data.ToVersion)
+	commit, err := createCommitOnBranch(ctx,
+		client,
+		ghrc.org+"/"+ghrc.name,
+		tempBranch,
+		baseOid,
+		headline,
+		creditPersona("", author),
+		hclPath,
+		base64.StdEncoding.EncodeToString(SetVersion([]byte(blob.Text), data.ToVersion)))
+	if err != nil {
+		return "", err
+	}
+	sha := commit.CreateCommitOnBranch.Commit.Oid
+
+	if _, err := updateRef(ctx, client, headRefId, sha, true); err != nil {
+		return "", fmt.Errorf("Error force updating head branch: %s", err)
+	}
+	return sha, nil
 }
 
 // This function will wait for up to 10 min for the status checks to complete
-func (ghrc *GitHubRepoContext) WaitForStatusChecks(ctx context.Context, prNumber int) error {
-	logger.Sugar().Infof("Waiting for status checks for PR %d", prNumber)
+func (ghrc *GitHubRepoContext) WaitForStatusChecks(ctx context.Context, prNumber int) (err error) {
+	ctx, span := tracer.Start(ctx, "wait for status checks", trace.WithAttributes(attrPRNumber.Int(prNumber)))
+	defer func() { endSpan(span, err) }()
+	start := time.Now()
+	defer func() { ghrc.metrics.ObserveStatusCheckWait(time.Since(start)) }()
+
+	ghrc.logger.Sugar().Infof("Waiting for status checks for PR %d", prNumber)
 	timeout := time.After(10 * time.Minute)
 	tick := time.Tick(10 * time.Second)
 
+	// Status events only name the commit, so the head commit is subscribed to
+	// as well once it is known
+	var headOid string
+	var headSeen time.Time
+	wake, unsubscribe := ghrc.webhooks.Subscribe(pullRequestKey(prNumber))
+	defer func() { unsubscribe() }()
+
 	for {
 		select {
 		case <-timeout:
 			return errors.New("Timed out after 10 minutes waiting for status checks")
 		case <-tick:
-			pr, err := getPullRequestStatusCheckRollup(ctx,
-				ghrc.client,
-				ghrc.org,
-				ghrc.name,
-				prNumber)
-
-			if err != nil {
-				return err
-			}
+		case <-wake:
+		}
+		ghrc.status.Heartbeat()
 
-			switch pr.Repository.PullRequest.StatusCheckRollup.State {
-			case "SUCCESS":
-				return nil
-			case "FAILURE":
-				return fmt.Errorf("PR %d failed status checks", prNumber)
-			case "PENDING":
-				continue
-			case "ERROR":
-				return fmt.Errorf("PR %d errored status checks", prNumber)
-			case "EXPECTED":
+		pr, err := getPullRequestStatusCheckRollup(ctx,
+			ghrc.client,
+			ghrc.org,
+			ghrc.name,
+			prNumber)
+
+		if err != nil {
+			return err
+		}
+
+		if oid := pr.Repository.PullRequest.HeadRefOid; oid != headOid {
+			unsubscribe()
+			wake, unsubscribe = ghrc.webhooks.Subscribe(pullRequestKey(prNumber), shaKey(oid))
+			headOid, headSeen = oid, time.Now()
+		}
+
+		switch pr.Repository.PullRequest.StatusCheckRollup.State {
+		case "":
+			// The rollup is also null until CI picks up a new head commit, so
+			// only a repository with no checks keeps it null past the grace
+			if time.Since(headSeen) < ghrc.statusCheckGrace {
 				continue
-			default:
-				return fmt.Errorf("Unknown status check state: %s", pr.Repository.PullRequest.StatusCheckRollup.State)
 			}
-
+			ghrc.logger.Sugar().Infof("PR %d has no status checks after %s", prNumber, ghrc.statusCheckGrace)
+			return nil
+		case "SUCCESS":
+			return nil
+		case "FAILURE":
+			return fmt.Errorf("PR %d failed: %w", prNumber, ErrStatusChecksFailed)
+		case "PENDING":
+			continue
+		case "ERROR":
+			return fmt.Errorf("PR %d errored: %w", prNumber, ErrStatusChecksFailed)
+		case "EXPECTED":
+			continue
+		default:
+			return fmt.Errorf("Unknown status check state: %s", pr.Repository.PullRequest.StatusCheckRollup.State)
 		}
 	}
 }
 
-func NeedsDowngrade(dir string) (bool, error) {
-	f := filepath.Join(dir, hclPath)
-	bb, err := os.ReadFile(f)
-	if err != nil {
-		logger.Sugar().Errorf("Error reading file: %s", err)
-		return false, err
+// Sleeps for the given duration unless the context is cancelled first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Returns the module version currently referenced in the given file contents,
+// or an empty string if none is found.
+func CurrentVersion(bb []byte) string {
+	re := regexp.MustCompile(versionReExpression)
+	match := re.FindSubmatch(bb)
+	if match == nil {
+		return ""
+	}
+	return string(match[1])
+}
+
+// Flips the module version in the given file contents between the up to date
+// and the downgraded version. Records the change on data and returns the
+// updated contents.
+func ApplyVersionChange(bb []byte, data *ChangeTemplateData) []byte {
+	changeString := "v0.6.2"
+	data.ChangeType = "upgrade"
+	if regexp.MustCompile(upToDateReExpression).Match(bb) {
+		changeString = "v0.3.0"
+		data.ChangeType = "downgrade"
 	}
+	data.FromVersion = CurrentVersion(bb)
+	data.ToVersion = changeString
 
-	re := regexp.MustCompile(upToDateReExpression)
-	return re.Match(bb), nil
+	return SetVersion(bb, changeString)
+}
+
+// Sets the module version in the given file contents
+func SetVersion(bb []byte, version string) []byte {
+	re := regexp.MustCompile(reExpression)
+	return re.ReplaceAll(bb, []byte("${1}"+version))
 }
 
 func GenerateChangeRemoteBranch(
+	ctx context.Context,
 	dir string,
 	ghrc *GitHubRepoContext,
 	repo *git.Repository,
-	logger *zap.Logger) (string, error) {
+	data *ChangeTemplateData,
+	persona *Persona,
+	logger *zap.Logger) (*RenderedChange, error) {
 
-	// Create a new branch
 	worktree, err := repo.Worktree()
 	if err != nil {
 		logger.Sugar().Errorf("Error getting worktree: %s", err)
-		return "", err
-	}
-
-	epochMilliseconds := time.Now().UnixMilli()
-	branchName := "dora-the-explorer-" + strconv.FormatInt(epochMilliseconds, 10)
-	newBranch := plumbing.NewBranchReferenceName(branchName)
-
-	err = worktree.Checkout(&git.CheckoutOptions{
-		Branch: newBranch,
-		Create: true,
-	})
-	if err != nil {
-		logger.Sugar().Errorf("Error creating new branch: %s", err)
-		return "", err
+		return nil, err
 	}
 
 	// Make changes (if any)
@@ -293,60 +542,69 @@ func GenerateChangeRemoteBranch(
 	bb, err := os.ReadFile(f)
 	if err != nil {
 		logger.Sugar().Errorf("Error reading file: %s", err)
-		return "", err
+		return nil, err
 	}
 
-	changeString := "v0.6.2"
-	if needsDowngrade, err := NeedsDowngrade(dir); err == nil && needsDowngrade {
-		changeString = "v0.3.0"
-	} else if err != nil {
-		logger.Sugar().Errorf("Error checking for downgrade: %s", err)
-		return "", err
+	updatedContent := ApplyVersionChange(bb, data)
+
+	change, err := ghrc.templates.Render(data)
+	if err != nil {
+		logger.Sugar().Errorf("Error rendering change templates: %s", err)
+		return nil, err
+	}
+
+	// Create a new branch
+	newBranch := plumbing.NewBranchReferenceName(change.BranchName)
+	err = worktree.Checkout(&git.CheckoutOptions{
+		Branch: newBranch,
+		Create: true,
+	})
+	if err != nil {
+		logger.Sugar().Errorf("Error creating new branch: %s", err)
+		return nil, err
 	}
 
-	re := regexp.MustCompile(reExpression)
-	updatedContent := re.ReplaceAll(bb, []byte("${1}"+changeString))
 	err = os.WriteFile(f, updatedContent, 0600)
 	if err != nil {
 		logger.Sugar().Errorf("Error writing to file: %s", err)
-		return "", err
+		return nil, err
 	}
 
 	// Add the file to the staging area
 	_, err = worktree.Add("envs/dev/terragrunt.hcl")
 	if err != nil {
 		logger.Sugar().Errorf("Error adding file to staging area: %s", err)
-		return "", err
+		return nil, err
 	}
 
 	// Commit the changes
-	_, err = worktree.Commit("Updated version in terragrunt.hcl", &git.CommitOptions{
+	commit, err := worktree.Commit(change.CommitMessage, &git.CommitOptions{
 		Author: &object.Signature{
-			Name:  "Bill Murray",
-			Email: "ghostbuster-bill@hookandladder8.com",
+			Name:  persona.Name,
+			Email: persona.Email,
 			When:  time.Now(),
 		},
 	})
 	if err != nil {
 		logger.Sugar().Errorf("Error committing changes: %s", err)
-		return "", err
+		return nil, err
 	}
 
 	// Push the new branch to the remote repository
-	err = repo.Push(&git.PushOptions{
+	ctx, span := tracer.Start(ctx, "push")
+	err = repo.PushContext(ctx, &git.PushOptions{
 		RemoteName: "origin",
-		Auth: &githttp.BasicAuth{
-			Username: "trashpandas",
-			Password: ghrc.pat,
-		},
+		Auth:       ghrc.gitAuth("trashpandas", ghrc.tokenFor(persona)),
 		RefSpecs: []config.RefSpec{
-			config.RefSpec("refs/heads/" + branchName + ":refs/heads/" + branchName),
+			config.RefSpec("refs/heads/" + change.BranchName + ":refs/heads/" + change.BranchName),
 		},
 	})
+	endSpan(span, err)
 	if err != nil {
 		logger.Sugar().Errorf("Error pushing to remote: %s", err)
-		return "", err
+		return nil, err
 	}
 
-	return branchName, nil
+	change.HeadSHA = commit.String()
+	return change, nil
 }
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func mergeabilityResponse(mergeable string, status string) string {
+	return `{"repository":{"pullRequest":{"id":"PR_1","mergeable":"` + mergeable + `","mergeStateStatus":"` + status + `",
+		"headRefName":"dora-the-explorer-1","headRefOid":"head123","headRef":{"id":"REF_HEAD"},
+		"baseRef":{"target":{"__typename":"Commit","oid":"base456"}}}}}`
+}
+
+func TestUpdateBaseBranchConflicting(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestMergeability": mergeabilityResponse("CONFLICTING", "DIRTY"),
+		"getRepositoryFile":          `{"repository":{"object":{"__typename":"Blob","text":"source = \"git::https://github.com/liatrio/dora-lambda-tf-module-demo?ref=v0.4.0\"","isBinary":false}}}`,
+		"getRepoId":                  `{"repository":{"id":"R_1"}}`,
+		"createRef":                  `{"createRef":{"ref":{"id":"REF_TEMP","name":"refs/heads/temp"}}}`,
+		"createCommitOnBranch":       `{"createCommitOnBranch":{"commit":{"oid":"new789","url":"https://example.com"}}}`,
+		"updateRef":                  `{"updateRef":{"ref":{"id":"REF_HEAD"}}}`,
+		"deleteRef":                  `{"deleteRef":{"clientMutationId":null}}`,
+	})
+
+	data := &ChangeTemplateData{ChangeType: "upgrade", FromVersion: "v0.3.0", ToVersion: "v0.6.2"}
+	updated, err := ghrc.UpdateBaseBranch(context.Background(), zap.NewNop(), 1, &defaultPersona, data)
+	if err != nil {
+		t.Fatalf("Error updating base branch: %s", err)
+	}
+	if !updated {
+		t.Errorf("Expected a conflicting PR to be updated")
+	}
+
+	if fake.calls["getRepositoryFile"]["expression"] != "base456:"+hclPath {
+		t.Errorf("Expected the change to be re-applied on the base head, got %v", fake.calls["getRepositoryFile"]["expression"])
+	}
+	if fake.calls["createCommitOnBranch"]["expectedHeadOid"] != "base456" {
+		t.Errorf("Expected the commit on top of base456, got %v", fake.calls["createCommitOnBranch"]["expectedHeadOid"])
+	}
+	contents, _ := base64.StdEncoding.DecodeString(fake.calls["createCommitOnBranch"]["contents"].(string))
+	if CurrentVersion(contents) != "v0.6.2" {
+		t.Errorf("Expected the re-applied change to set v0.6.2, got %s", contents)
+	}
+
+	update := fake.calls["updateRef"]
+	if update["refId"] != "REF_HEAD" || update["oid"] != "new789" || update["force"] != true {
+		t.Errorf("Expected the head ref to be force updated to new789, got %v", update)
+	}
+	if fake.calls["deleteRef"]["refId"] != "REF_TEMP" {
+		t.Errorf("Expected the temporary branch to be deleted, got %v", fake.calls["deleteRef"])
+	}
+}
+
+func TestUpdateBaseBranchBehind(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestMergeability": mergeabilityResponse("MERGEABLE", "BEHIND"),
+		"updatePullRequestBranch":    `{"updatePullRequestBranch":{"pullRequest":{"headRefOid":"new789"}}}`,
+	})
+	ghrc.updateBranchMethod = PullRequestBranchUpdateMethodRebase
+
+	updated, err := ghrc.UpdateBaseBranch(context.Background(), zap.NewNop(), 1, &defaultPersona, &ChangeTemplateData{})
+	if err != nil {
+		t.Fatalf("Error updating base branch: %s", err)
+	}
+	if !updated {
+		t.Errorf("Expected a PR that is behind to be updated")
+	}
+	call := fake.calls["updatePullRequestBranch"]
+	if call["expectedHeadOid"] != "head123" || call["updateMethod"] != "REBASE" {
+		t.Errorf("Unexpected update call: %v", call)
+	}
+}
+
+func TestUpdateBaseBranchClean(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestMergeability": mergeabilityResponse("MERGEABLE", "CLEAN"),
+	})
+
+	updated, err := ghrc.UpdateBaseBranch(context.Background(), zap.NewNop(), 1, &defaultPersona, &ChangeTemplateData{})
+	if err != nil {
+		t.Fatalf("Error updating base branch: %s", err)
+	}
+	if updated || fake.counts["updatePullRequestBranch"] != 0 {
+		t.Errorf("Expected a clean PR to be left alone")
+	}
+}
+
+func TestGetLastDeployment(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, nil)
+	fake.pages["getLatestDeployments"] = []string{
+		`{"repository":{"deployments":{"pageInfo":{"hasNextPage":true,"endCursor":"page2"},"nodes":[
+			{"createdAt":"2024-06-03T10:00:00Z","environment":"production","state":"FAILURE","statuses":{"nodes":[
+				{"updatedAt":"2024-06-03T10:05:00Z","state":"FAILURE","environment":"production"}]}}
+		]}}}`,
+		`{"repository":{"deployments":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"createdAt":"2024-06-02T10:00:00Z","environment":"production","state":"INACTIVE","statuses":{"nodes":[
+				{"updatedAt":"2024-06-02T12:00:00Z","state":"INACTIVE","environment":"production"},
+				{"updatedAt":"2024-06-02T10:10:00Z","state":"SUCCESS","environment":"production"}]}}
+		]}}}`,
+	}
+
+	filter := DeploymentFilter{Environment: "production", States: []DeploymentStatusState{DeploymentStatusStateSuccess}}
+	last, err := ghrc.GetLastDeployment(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("Error getting last deployment: %s", err)
+	}
+	if last == nil || !last.DeployedAt.Equal(time.Date(2024, 6, 2, 10, 10, 0, 0, time.UTC)) {
+		t.Errorf("Expected the successful deployment from the second page, got %v", last)
+	}
+	if fake.counts["getLatestDeployments"] != 2 || fake.calls["getLatestDeployments"]["cursor"] != "page2" {
+		t.Errorf("Expected the second page to be requested, got %v", fake.calls["getLatestDeployments"])
+	}
+	if envs, ok := fake.calls["getLatestDeployments"]["environments"].([]interface{}); !ok || len(envs) != 1 || envs[0] != "production" {
+		t.Errorf("Expected deployments to be filtered to production, got %v", fake.calls["getLatestDeployments"])
+	}
+
+	// Without a state filter the latest status of the newest deployment counts
+	fake, ghrc = newFakeGitHub(t, map[string]string{"getLatestDeployments": fake.pages["getLatestDeployments"][0]})
+	last, err = ghrc.GetLastDeployment(context.Background(), DeploymentFilter{})
+	if err != nil {
+		t.Fatalf("Error getting last deployment: %s", err)
+	}
+	if last == nil || !last.DeployedAt.Equal(time.Date(2024, 6, 3, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("Expected the failed deployment, got %v", last)
+	}
+	if _, ok := fake.calls["getLatestDeployments"]["environments"]; ok {
+		t.Errorf("Expected no environment filter, got %v", fake.calls["getLatestDeployments"])
+	}
+}
+
+// Wakes the waiters on the key until the returned function is called, so tests
+// do not wait for the poll interval
+func keepWaking(wr *WebhookReceiver, key string) func() {
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				wr.wake(key)
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func TestWaitForStatusChecksWithoutChecks(t *testing.T) {
+	_, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestStatusCheckRollup": `{"repository":{"pullRequest":{"statusCheckRollup":null}}}`,
+	})
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	if err := ghrc.WaitForStatusChecks(context.Background(), 7); err != nil {
+		t.Errorf("Expected a PR without status checks to pass, got %s", err)
+	}
+}
+
+func TestWaitForStatusChecksGraceForNewCommits(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, nil)
+	fake.pages["getPullRequestStatusCheckRollup"] = []string{
+		`{"repository":{"pullRequest":{"headRefOid":"abc123","statusCheckRollup":null}}}`,
+		`{"repository":{"pullRequest":{"headRefOid":"abc123","statusCheckRollup":{"state":"FAILURE"}}}}`,
+	}
+	ghrc.statusCheckGrace = time.Hour
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	// Within the grace a null rollup means CI has not picked up the commit yet
+	if err := ghrc.WaitForStatusChecks(context.Background(), 7); !errors.Is(err, ErrStatusChecksFailed) {
+		t.Errorf("Expected the checks that showed up to fail, got %v", err)
+	}
+}
+
+// Waits until something subscribed to the key
+func waitForSubscriber(t *testing.T, wr *WebhookReceiver, key string) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		wr.mu.Lock()
+		subscribed := len(wr.waiters[key]) > 0
+		wr.mu.Unlock()
+		if subscribed {
+			return
+		}
+	}
+	t.Fatalf("Nothing subscribed to %s", key)
+}
+
+func TestWaitForStatusChecksWokenByStatus(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, nil)
+	fake.pages["getPullRequestStatusCheckRollup"] = []string{
+		`{"repository":{"pullRequest":{"headRefOid":"abc123","statusCheckRollup":{"state":"PENDING"}}}}`,
+		`{"repository":{"pullRequest":{"headRefOid":"abc123","statusCheckRollup":{"state":"SUCCESS"}}}}`,
+	}
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() { done <- ghrc.WaitForStatusChecks(context.Background(), 7) }()
+
+	// A pull request event gets the head commit, then a status event on it
+	// ends the wait well before the next poll
+	waitForSubscriber(t, ghrc.webhooks, pullRequestKey(7))
+	ghrc.webhooks.ServeHTTP(httptest.NewRecorder(), signedWebhook("s3cret", "pull_request", `{"pull_request":{"number":7,"head":{"sha":"abc123"}}}`))
+	waitForSubscriber(t, ghrc.webhooks, shaKey("abc123"))
+	ghrc.webhooks.ServeHTTP(httptest.NewRecorder(), signedWebhook("s3cret", "status", `{"sha":"abc123"}`))
+
+	if err := <-done; err != nil {
+		t.Fatalf("Error waiting for status checks: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the status event to end the wait, took %s", elapsed)
+	}
+}
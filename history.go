@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	EventBranchPushed    = "branch_pushed"
+	EventPROpened        = "pr_opened"
+	EventChecksPassed    = "checks_passed"
+	EventChecksFailed    = "checks_failed"
+	EventChecksRetried   = "checks_retried"
+	EventChecksRecovered = "checks_recovered"
+	EventPRClosed        = "pr_closed"
+	EventPRLeftOpen      = "pr_left_open"
+	EventPRAbandoned     = "pr_abandoned"
+	EventPRIdle          = "pr_idle"
+	EventMerged          = "merged"
+	EventDeployStarted   = "deploy_started"
+	EventDeployed        = "deployed"
+	EventDeployFailed    = "deploy_failed"
+	EventIncidentOpened  = "incident_opened"
+	EventIncidentClosed  = "incident_closed"
+	EventRolledBack      = "rolled_back"
+)
+
+// Something that happened to a generated change
+type Event struct {
+	Time            time.Time `json:"time"`
+	Type            string    `json:"type"`
+	ChangeID        string    `json:"change_id,omitempty"`
+	WorkItem        string    `json:"work_item,omitempty"`
+	IntendedOutcome string    `json:"intended_outcome,omitempty"`
+	PRNumber        int       `json:"pr_number,omitempty"`
+	SHA             string    `json:"sha,omitempty"`
+	HeadRef         string    `json:"head_ref,omitempty"`
+	BaseRef         string    `json:"base_ref,omitempty"`
+	Environment     string    `json:"environment,omitempty"`
+	IncidentID      string    `json:"incident_id,omitempty"`
+	Detail          string    `json:"detail,omitempty"`
+}
+
+// A bounded, in-memory record of the most recent events. When a journal is
+// set every event is also appended to it as a JSON line.
+type History struct {
+	mu        sync.Mutex
+	events    []Event
+	max       int
+	change    *ChangeTemplateData
+	journal   io.Writer
+	observers []func(Event)
+	logger    *zap.Logger
+}
+
+func NewHistory(max int, logger *zap.Logger) *History {
+	return &History{max: max, logger: logger}
+}
+
+// Appends every following event to the journal as a JSON line
+func (h *History) SetJournal(journal io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.journal = journal
+}
+
+// Calls fn with every following event after it has been recorded
+func (h *History) AddObserver(fn func(Event)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.observers = append(h.observers, fn)
+}
+
+// Sets the change that following events belong to, events recorded without
+// a change ID are correlated with it
+func (h *History) StartChange(data *ChangeTemplateData) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.change = data
+}
+
+// Records an event, dropping the oldest one when the history is full. The
+// event time defaults to now.
+func (h *History) Record(e Event) {
+	if h == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	h.mu.Lock()
+	observers := h.observers
+	defer func() {
+		h.mu.Unlock()
+		for _, observe := range observers {
+			observe(e)
+		}
+	}()
+	if e.ChangeID == "" && h.change != nil {
+		e.ChangeID = strconv.FormatInt(h.change.Epoch, 10)
+		e.WorkItem = h.change.WorkItem
+		e.IntendedOutcome = h.change.IntendedOutcome
+	}
+
+	h.events = append(h.events, e)
+	if len(h.events) > h.max {
+		h.events = h.events[len(h.events)-h.max:]
+	}
+
+	if h.journal != nil {
+		line, err := json.Marshal(e)
+		if err == nil {
+			_, err = h.journal.Write(append(line, '\n'))
+		}
+		if err != nil {
+			h.logger.Sugar().Errorf("Error writing %s to the journal: %s", e.Type, err)
+		}
+	}
+}
+
+// Returns up to n of the most recent events, oldest first
+func (h *History) Recent(n int) []Event {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if n > len(h.events) {
+		n = len(h.events)
+	}
+	recent := make([]Event, n)
+	copy(recent, h.events[len(h.events)-n:])
+	return recent
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestHistoryJournal(t *testing.T) {
+	var journal bytes.Buffer
+	h := NewHistory(2, zap.NewNop())
+	h.SetJournal(&journal)
+
+	h.Record(Event{Type: EventPRIdle})
+	h.StartChange(&ChangeTemplateData{Epoch: 1718000000000, WorkItem: "DORA-1234", IntendedOutcome: IntendedOutcomeFailure})
+	h.Record(Event{Type: EventPROpened, PRNumber: 7})
+	h.Record(Event{Type: EventMerged, PRNumber: 7, SHA: "abc123"})
+
+	if recent := h.Recent(10); len(recent) != 2 || recent[0].Type != EventPROpened {
+		t.Errorf("Expected only the two most recent events to be kept, got %v", recent)
+	}
+
+	var records []Event
+	scanner := bufio.NewScanner(&journal)
+	for scanner.Scan() {
+		var record Event
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Error decoding journal line %q: %s", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected every event in the journal, got %v", records)
+	}
+	if records[0].ChangeID != "" {
+		t.Errorf("Expected the event before the change not to be correlated, got %v", records[0])
+	}
+	merged := records[2]
+	if merged.ChangeID != "1718000000000" || merged.WorkItem != "DORA-1234" || merged.IntendedOutcome != IntendedOutcomeFailure {
+		t.Errorf("Expected the event to be correlated with the change, got %v", merged)
+	}
+	if merged.SHA != "abc123" || merged.Time.IsZero() {
+		t.Errorf("Expected the merge SHA and a timestamp, got %v", merged)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"go.uber.org/zap"
+)
+
+// The files committed to a repository by the init command
+//
+//go:embed all:inittemplate
+var initTemplate embed.FS
+
+const initTemplateRoot = "inittemplate"
+
+type InitOptions struct {
+	Environments []string
+	Visibility   RepositoryVisibility
+}
+
+// Bootstraps a target repository that Dora the Explorer can generate changes
+// against. The repository is created in the org if it does not exist, an empty
+// one is filled. The initial commit holds the target file and a deploy
+// workflow exposing the `deploy` check, then the environments are created.
+func (ghrc *GitHubRepoContext) InitRepository(ctx context.Context, logger *zap.Logger, opts InitOptions) error {
+	owner, err := getRepositoryOwner(ctx, ghrc.client, ghrc.org, ghrc.name)
+	if err != nil {
+		return fmt.Errorf("Error looking up %s: %s", ghrc.org, err)
+	}
+	if owner.RepositoryOwner == nil {
+		return fmt.Errorf("No user or organization named %s", ghrc.org)
+	}
+
+	repoId := owner.RepositoryOwner.GetRepository().Id
+	switch {
+	case repoId == "":
+		logger.Sugar().Infof("Creating repository %s/%s", ghrc.org, ghrc.name)
+		created, err := createRepository(ctx,
+			ghrc.client,
+			owner.RepositoryOwner.GetId(),
+			ghrc.name,
+			"DORA sandbox managed by Dora the Explorer",
+			opts.Visibility)
+		if err != nil {
+			return fmt.Errorf("Error creating repository: %s", err)
+		}
+		repoId = created.CreateRepository.Repository.Id
+	case !owner.RepositoryOwner.GetRepository().IsEmpty:
+		return fmt.Errorf("Repository %s/%s already has commits, init only fills empty repositories", ghrc.org, ghrc.name)
+	default:
+		logger.Sugar().Infof("Filling empty repository %s/%s", ghrc.org, ghrc.name)
+	}
+
+	if err := ghrc.pushInitialCommit(ctx, logger); err != nil {
+		return err
+	}
+
+	for _, env := range opts.Environments {
+		if _, err := createEnvironment(ctx, ghrc.client, repoId, env); err != nil {
+			return fmt.Errorf("Error creating environment %s: %s", env, err)
+		}
+		logger.Sugar().Infof("Created environment %s", env)
+	}
+
+	return nil
+}
+
+// Commits the init template in memory and pushes it as the main branch
+func (ghrc *GitHubRepoContext) pushInitialCommit(ctx context.Context, logger *zap.Logger) error {
+	worktreeFs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), worktreeFs)
+	if err != nil {
+		return err
+	}
+
+	mainBranch := plumbing.NewBranchReferenceName("main")
+	if err := repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, mainBranch)); err != nil {
+		return err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	err = fs.WalkDir(initTemplate, initTemplateRoot, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		contents, err := initTemplate.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		name := p[len(initTemplateRoot)+1:]
+		if err := worktreeFs.MkdirAll(path.Dir(name), 0700); err != nil {
+			return err
+		}
+		f, err := worktreeFs.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(contents); err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		_, err = worktree.Add(name)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error writing init template: %s", err)
+	}
+
+	persona := ghrc.personas.Sample(time.Now())
+	_, err = worktree.Commit("Initialize DORA sandbox", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  persona.Name,
+			Email: persona.Email,
+			When:  time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error committing init template: %s", err)
+	}
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{ghrc.remoteRepoUrl},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = repo.PushContext(ctx, &git.PushOptions{
+		RemoteName: "origin",
+		Auth:       ghrc.gitAuth("dora-the-explorer", ghrc.tokenFor(persona)),
+		RefSpecs: []config.RefSpec{
+			config.RefSpec(mainBranch.String() + ":" + mainBranch.String()),
+		},
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("Error pushing initial commit: %s", err)
+	}
+	logger.Sugar().Infof("Pushed initial commit to %s", ghrc.remoteRepoUrl)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"go.uber.org/zap"
+)
+
+func TestInitRepository(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getRepositoryOwner": `{"repositoryOwner":{"__typename":"Organization","id":"O_1","repository":null}}`,
+		"createRepository":   `{"createRepository":{"repository":{"id":"R_1","url":"https://example.com"}}}`,
+		"createEnvironment":  `{"createEnvironment":{"environment":{"id":"E_1","name":"dev"}}}`,
+	})
+
+	remoteDir := t.TempDir()
+	remote, err := git.PlainInit(remoteDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ghrc.remoteRepoUrl = remoteDir
+	ghrc.personas, _ = NewPersonaPoolFromEnv()
+
+	err = ghrc.InitRepository(context.Background(), zap.NewNop(), InitOptions{
+		Environments: []string{"dev"},
+		Visibility:   RepositoryVisibilityPrivate,
+	})
+	if err != nil {
+		t.Fatalf("Error initializing repository: %s", err)
+	}
+
+	if fake.calls["createRepository"]["ownerId"] != "O_1" {
+		t.Errorf("Expected repository to be created for O_1, got %v", fake.calls["createRepository"]["ownerId"])
+	}
+	if fake.calls["createEnvironment"]["repositoryId"] != "R_1" {
+		t.Errorf("Expected environment to be created in R_1, got %v", fake.calls["createEnvironment"]["repositoryId"])
+	}
+
+	ref, err := remote.Reference(plumbing.NewBranchReferenceName("main"), true)
+	if err != nil {
+		t.Fatalf("Expected main to be pushed: %s", err)
+	}
+	commit, err := remote.CommitObject(ref.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{hclPath, ".github/workflows/deploy.yml"} {
+		file, err := commit.File(name)
+		if err != nil {
+			t.Errorf("Expected %s in the initial commit: %s", name, err)
+			continue
+		}
+		if name == hclPath {
+			contents, _ := file.Contents()
+			if CurrentVersion([]byte(contents)) != "v0.3.0" {
+				t.Errorf("Expected target file to reference v0.3.0, got %s", contents)
+			}
+		}
+	}
+}
+
+func TestInitRepositoryMissingOwner(t *testing.T) {
+	_, ghrc := newFakeGitHub(t, map[string]string{"getRepositoryOwner": `{"repositoryOwner":null}`})
+	err := ghrc.InitRepository(context.Background(), zap.NewNop(), InitOptions{})
+	if err == nil || !strings.Contains(err.Error(), "No user or organization named test-org") {
+		t.Errorf("Expected an error for a missing owner, got %v", err)
+	}
+}
+
+func TestInitRepositoryRefusesNonEmpty(t *testing.T) {
+	_, ghrc := newFakeGitHub(t, map[string]string{
+		"getRepositoryOwner": `{"repositoryOwner":{"__typename":"User","id":"U_1","repository":{"id":"R_1","isEmpty":false}}}`,
+	})
+
+	if err := ghrc.InitRepository(context.Background(), zap.NewNop(), InitOptions{}); err == nil {
+		t.Errorf("Expected an error initializing a repository with commits")
+	}
+}
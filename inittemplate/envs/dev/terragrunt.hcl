@@ -0,0 +1,5 @@
+# Managed by Dora the Explorer. Every generated change flips the module version
+# below between v0.3.0 and v0.6.2.
+terraform {
+  source = "git::https://github.com/liatrio/dora-lambda-tf-module-demo?ref=v0.3.0"
+}
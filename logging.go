@@ -0,0 +1,209 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Replaces redacted secrets in log messages and fields
+const redacted = "[REDACTED]"
+
+// Secrets that are redacted even when they were never registered: GitHub
+// personal access, OAuth, user, installation and refresh tokens, and the
+// password of credentials embedded in URLs, as in git transport errors.
+var secretPatterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{20,}\b`), redacted},
+	{regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{20,}\b`), redacted},
+	{regexp.MustCompile(`(://[^/\s:@]+:)[^@\s/]+@`), "${1}" + redacted + "@"},
+}
+
+// Removes secrets from text before it is logged. Secrets registered with
+// AddSecrets are replaced wherever they occur, tokens that look like GitHub
+// tokens whether or not they were registered.
+type Redactor struct {
+	mu      sync.RWMutex
+	secrets []string
+}
+
+func NewRedactor() *Redactor {
+	return &Redactor{}
+}
+
+func (r *Redactor) AddSecrets(secrets ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, secret := range secrets {
+		if secret != "" {
+			r.secrets = append(r.secrets, secret)
+		}
+	}
+}
+
+func (r *Redactor) Redact(s string) string {
+	r.mu.RLock()
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	r.mu.RUnlock()
+	for _, p := range secretPatterns {
+		s = p.re.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+// Redacts the message and the string, error and stringer fields of every
+// entry before it is written
+type redactingCore struct {
+	zapcore.Core
+	redactor *Redactor
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactFields(fields)), redactor: c.redactor}
+}
+
+func (c *redactingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *redactingCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = c.redactor.Redact(ent.Message)
+	ent.Stack = c.redactor.Redact(ent.Stack)
+	return c.Core.Write(ent, c.redactFields(fields))
+}
+
+func (c *redactingCore) redactFields(fields []zapcore.Field) []zapcore.Field {
+	redactedFields := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch f.Type {
+		case zapcore.StringType:
+			f.String = c.redactor.Redact(f.String)
+		case zapcore.ByteStringType:
+			f = zap.String(f.Key, c.redactor.Redact(string(f.Interface.([]byte))))
+		case zapcore.ErrorType:
+			if err, ok := f.Interface.(error); ok && err != nil {
+				f = zap.NamedError(f.Key, errors.New(c.redactor.Redact(err.Error())))
+			}
+		case zapcore.StringerType:
+			if s, ok := f.Interface.(fmt.Stringer); ok && s != nil {
+				f = zap.String(f.Key, c.redactor.Redact(s.String()))
+			}
+		}
+		redactedFields[i] = f
+	}
+	return redactedFields
+}
+
+// Returns the secrets configured through the environment: the PAT, the
+// persona and reviewer tokens and the webhook secret
+func secretsFromEnv() []string {
+	secrets := []string{os.Getenv("GH_PAT"), os.Getenv("DORA_WEBHOOK_SECRET")}
+	for _, reviewer := range NewReviewersFromTokens(os.Getenv("DORA_REVIEWER_TOKENS")) {
+		secrets = append(secrets, reviewer.Token)
+	}
+	if pool, err := NewPersonaPoolFromEnv(); err == nil {
+		for _, persona := range pool.Personas {
+			secrets = append(secrets, persona.Token)
+		}
+	}
+	return secrets
+}
+
+// Builds the logger from DORA_LOG_LEVEL, DORA_LOG_FORMAT, DORA_LOG_SAMPLING
+// and DORA_LOG_OUTPUT. Everything it writes passes through the redactor.
+func NewLogger(redactor *Redactor) (*zap.Logger, error) {
+	cfg := zap.Config{
+		Level:            zap.NewAtomicLevelAt(zapcore.DebugLevel),
+		Encoding:         "json",
+		OutputPaths:      []string{"stdout"},
+		ErrorOutputPaths: []string{"stderr"},
+		InitialFields:    map[string]interface{}{"service": "dora-the-explorer"},
+		EncoderConfig: zapcore.EncoderConfig{
+			MessageKey:  "message",
+			LevelKey:    "level",
+			EncodeLevel: zapcore.LowercaseLevelEncoder,
+		},
+	}
+
+	if v := os.Getenv("DORA_LOG_LEVEL"); v != "" {
+		level, err := zapcore.ParseLevel(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_LOG_LEVEL: %s", err)
+		}
+		cfg.Level.SetLevel(level)
+	}
+
+	switch format := strings.ToLower(os.Getenv("DORA_LOG_FORMAT")); format {
+	case "", "json":
+	case "console":
+		cfg.Encoding = "console"
+		cfg.EncoderConfig.TimeKey = "time"
+		cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		cfg.EncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	default:
+		return nil, fmt.Errorf("Unknown DORA_LOG_FORMAT: %s", format)
+	}
+
+	if v := os.Getenv("DORA_LOG_OUTPUT"); v != "" {
+		cfg.OutputPaths = nil
+		for _, path := range strings.Split(v, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				cfg.OutputPaths = append(cfg.OutputPaths, path)
+			}
+		}
+	}
+
+	initial, thereafter, err := parseLogSampling(os.Getenv("DORA_LOG_SAMPLING"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Sample outside the redacting core, so dropped entries are never redacted
+	return cfg.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		core = &redactingCore{Core: core, redactor: redactor}
+		if initial > 0 {
+			core = zapcore.NewSamplerWithOptions(core, time.Second, initial, thereafter)
+		}
+		return core
+	}))
+}
+
+// Parses DORA_LOG_SAMPLING, "initial,thereafter" to log the first initial
+// entries with the same level and message each second and every thereafter
+// entry after that. "true" samples like zap's production logger, 100,100.
+// Sampling is off when it is empty or "false".
+func parseLogSampling(s string) (initial int, thereafter int, err error) {
+	switch strings.ToLower(s) {
+	case "", "false":
+		return 0, 0, nil
+	case "true":
+		return 100, 100, nil
+	}
+
+	first, rest, found := strings.Cut(s, ",")
+	if !found {
+		return 0, 0, fmt.Errorf("Invalid DORA_LOG_SAMPLING %q, expected initial,thereafter", s)
+	}
+	if initial, err = strconv.Atoi(strings.TrimSpace(first)); err != nil || initial <= 0 {
+		return 0, 0, fmt.Errorf("Invalid DORA_LOG_SAMPLING %q, expected initial,thereafter", s)
+	}
+	if thereafter, err = strconv.Atoi(strings.TrimSpace(rest)); err != nil || thereafter < 0 {
+		return 0, 0, fmt.Errorf("Invalid DORA_LOG_SAMPLING %q, expected initial,thereafter", s)
+	}
+	return initial, thereafter, nil
+}
@@ -4,54 +4,20 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
-var (
-	logger *zap.Logger
-)
-
-func init() {
-	var err error
-
-	rawJSON := []byte(`{
-        "level": "debug",
-        "encoding": "json",
-        "outputPaths": ["stdout"],
-        "errorOutputPaths": ["stderr"],
-        "initialFields": {"service": "dora-the-explorer"},
-        "encoderConfig": {
-            "messageKey": "message",
-            "levelKey": "level",
-            "levelEncoder": "lowercase"
-            }
-        }
-    `)
-
-	var cfg zap.Config
-	if err = json.Unmarshal(rawJSON, &cfg); err != nil {
-		panic(err)
-	}
-
-	logger = zap.Must(cfg.Build())
-
-	defer func() {
-		if err := logger.Sync(); err != nil {
-			return
-		}
-	}()
-}
-
-func prepEnvironment() (ghrc *GitHubRepoContext, doraTeam *DoraTeam, err error) {
-	ghrc = &GitHubRepoContext{}
-	ghrc.logger = logger
+func prepEnvironment(logger *zap.Logger) (ghrc *GitHubRepoContext, doraTeam *DoraTeam, err error) {
 	doraTeamPerformanceLevel := strings.ToLower(os.Getenv("DORA_TEAM_PERFORMANCE_LEVEL"))
 	if doraTeamPerformanceLevel == "" {
 		return nil, nil, errors.New("DORA_TEAM_PERFORMANCE_LEVEL is not set")
@@ -71,14 +37,57 @@ func prepEnvironment() (ghrc *GitHubRepoContext, doraTeam *DoraTeam, err error)
 		return nil, nil, fmt.Errorf("Unknown team performance level: %s", doraTeamPerformanceLevel)
 	}
 
+	if err = applyReviewOverrides(&doraTeam.Review); err != nil {
+		return nil, nil, err
+	}
+
+	if err = applyIdleOverrides(&doraTeam.Idle); err != nil {
+		return nil, nil, err
+	}
+
+	if err = applyDeployOverrides(&doraTeam.Deploy); err != nil {
+		return nil, nil, err
+	}
+
+	if v := os.Getenv("DORA_MERGE_METHODS"); v != "" {
+		if doraTeam.MergeMethods, err = ParseMergeMethods(v); err != nil {
+			return nil, nil, fmt.Errorf("Error parsing DORA_MERGE_METHODS: %s", err)
+		}
+	}
+
+	ghrc, err = prepRepoContext(logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ghrc.metrics = NewSimulatorMetrics(ghrc.org, ghrc.name, doraTeam.Level, ghrc.cadenceFilter)
+	ghrc.history.AddObserver(ghrc.metrics.Observe)
+
+	if strings.ToLower(os.Getenv("DORA_CICD_EVENTS")) == "true" {
+		// The events are span events, without tracing they would go nowhere
+		if !tracingEnabled() {
+			return nil, nil, errors.New("DORA_CICD_EVENTS requires tracing, set OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+		}
+		ghrc.cicdEvents = NewCICDEventEmitter(ghrc)
+		ghrc.history.AddObserver(ghrc.cicdEvents.Observe)
+	}
+
+	return ghrc, doraTeam, nil
+}
+
+// Builds the repository context from the GH_* and DORA_* environment variables
+func prepRepoContext(logger *zap.Logger) (ghrc *GitHubRepoContext, err error) {
+	ghrc = &GitHubRepoContext{}
+	ghrc.logger = logger
+
 	ghrc.pat = os.Getenv("GH_PAT")
 	if ghrc.pat == "" {
-		return nil, nil, errors.New("GH_PAT is not set")
+		return nil, errors.New("GH_PAT is not set")
 	}
 
 	ghrc.org = os.Getenv("GH_ORG")
 	if ghrc.org == "" {
-		return nil, nil, errors.New("GH_ORG is not set")
+		return nil, errors.New("GH_ORG is not set")
 	}
 
 	graphqlUrl := os.Getenv("GH_GRAPHQL_URL")
@@ -91,74 +100,743 @@ func prepEnvironment() (ghrc *GitHubRepoContext, doraTeam *DoraTeam, err error)
 		ghrc.gitHubDomain = "https://github.com"
 	}
 
+	ghrc.graphqlUrl = graphqlUrl
 	ghrc.client = ghrc.generateClient(graphqlUrl)
 
 	ghrc.name = os.Getenv("GH_REPO_NAME")
 	if ghrc.name == "" {
-		return nil, nil, errors.New("GH_REPO_NAME is not set")
+		return nil, errors.New("GH_REPO_NAME is not set")
 	}
 
 	ghrc.remoteRepoUrl, err = ghrc.CalculateRepoUrl()
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error calculating repo URL: %s", err)
+		return nil, fmt.Errorf("Error calculating repo URL: %s", err)
 	}
 
-	return ghrc, doraTeam, nil
+	ghrc.localDir = os.Getenv("DORA_WORKDIR")
+	ghrc.shallowClone = strings.ToLower(os.Getenv("DORA_SHALLOW_CLONE")) == "true"
+
+	ghrc.changeMode = strings.ToLower(os.Getenv("DORA_CHANGE_MODE"))
+	switch ghrc.changeMode {
+	case "":
+		ghrc.changeMode = ChangeModeGit
+	case ChangeModeGit, ChangeModeAPI:
+	default:
+		return nil, fmt.Errorf("Unknown change mode: %s", ghrc.changeMode)
+	}
+
+	ghrc.templates, err = NewChangeTemplatesFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("Error loading change templates: %s", err)
+	}
+
+	ghrc.personas, err = NewPersonaPoolFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("Error loading personas: %s", err)
+	}
+
+	ghrc.reviewers = NewReviewersFromTokens(os.Getenv("DORA_REVIEWER_TOKENS"))
+
+	ghrc.deleteHeadBranch = strings.ToLower(os.Getenv("DORA_DELETE_HEAD_BRANCH")) != "false"
+
+	ghrc.checkFailurePolicy = strings.ToLower(os.Getenv("DORA_CHECK_FAILURE_POLICY"))
+	switch ghrc.checkFailurePolicy {
+	case "":
+		ghrc.checkFailurePolicy = CheckFailurePolicyClose
+	case CheckFailurePolicyClose, CheckFailurePolicyLeave, CheckFailurePolicyRetry:
+	default:
+		return nil, fmt.Errorf("Unknown check failure policy: %s", ghrc.checkFailurePolicy)
+	}
+
+	ghrc.checkFailureRetries = 2
+	if v := os.Getenv("DORA_CHECK_FAILURE_RETRIES"); v != "" {
+		if ghrc.checkFailureRetries, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_CHECK_FAILURE_RETRIES: %s", err)
+		}
+		if ghrc.checkFailureRetries < 0 {
+			return nil, fmt.Errorf("Invalid DORA_CHECK_FAILURE_RETRIES %d: must not be negative", ghrc.checkFailureRetries)
+		}
+	}
+
+	ghrc.history = NewHistory(100, logger)
+
+	ghrc.updateBranchMethod = PullRequestBranchUpdateMethod(strings.ToUpper(os.Getenv("DORA_UPDATE_BRANCH_METHOD")))
+	switch ghrc.updateBranchMethod {
+	case "":
+		ghrc.updateBranchMethod = PullRequestBranchUpdateMethodMerge
+	case PullRequestBranchUpdateMethodMerge, PullRequestBranchUpdateMethodRebase:
+	default:
+		return nil, fmt.Errorf("Unknown update branch method: %s", ghrc.updateBranchMethod)
+	}
+
+	ghrc.mergeMode = strings.ToLower(os.Getenv("DORA_MERGE_MODE"))
+	switch ghrc.mergeMode {
+	case "":
+		ghrc.mergeMode = MergeModeDirect
+	case MergeModeDirect, MergeModeAuto, MergeModeQueue:
+	default:
+		return nil, fmt.Errorf("Unknown merge mode: %s", ghrc.mergeMode)
+	}
+
+	ghrc.deployMode = strings.ToLower(os.Getenv("DORA_DEPLOY_MODE"))
+	switch ghrc.deployMode {
+	case "":
+		ghrc.deployMode = DeployModeWorkflow
+	case DeployModeWorkflow, DeployModeAPI:
+	default:
+		return nil, fmt.Errorf("Unknown deploy mode: %s", ghrc.deployMode)
+	}
+
+	ghrc.recoveryStrategy = strings.ToLower(os.Getenv("DORA_RECOVERY_STRATEGY"))
+	switch ghrc.recoveryStrategy {
+	case "":
+		ghrc.recoveryStrategy = RecoveryStrategyForward
+	case RecoveryStrategyForward, RecoveryStrategyRollback:
+	default:
+		return nil, fmt.Errorf("Unknown recovery strategy: %s", ghrc.recoveryStrategy)
+	}
+	if ghrc.recoveryStrategy == RecoveryStrategyRollback && ghrc.deployMode != DeployModeAPI {
+		return nil, errors.New("DORA_RECOVERY_STRATEGY=rollback needs DORA_DEPLOY_MODE=api")
+	}
+
+	deployChecks := os.Getenv("DORA_DEPLOY_CHECKS")
+	if deployChecks == "" {
+		deployChecks = "name:^deploy$"
+	}
+	if ghrc.deployChecks, err = ParseDeployChecks(deployChecks); err != nil {
+		return nil, fmt.Errorf("Error parsing DORA_DEPLOY_CHECKS: %s", err)
+	}
+
+	ghrc.deployCheckGrace = 2 * time.Minute
+	if v := os.Getenv("DORA_DEPLOY_CHECK_GRACE"); v != "" {
+		if ghrc.deployCheckGrace, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_DEPLOY_CHECK_GRACE: %s", err)
+		}
+	}
+
+	ghrc.statusCheckGrace = 2 * time.Minute
+	if v := os.Getenv("DORA_STATUS_CHECK_GRACE"); v != "" {
+		if ghrc.statusCheckGrace, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_STATUS_CHECK_GRACE: %s", err)
+		}
+	}
+
+	if os.Getenv("DORA_WEBHOOK_ADDR") != "" {
+		secret := os.Getenv("DORA_WEBHOOK_SECRET")
+		if secret == "" {
+			return nil, errors.New("DORA_WEBHOOK_SECRET is required to receive webhooks")
+		}
+		ghrc.webhooks = NewWebhookReceiver(secret, logger)
+	}
+
+	ghrc.cadenceFilter.Environment = os.Getenv("DORA_CADENCE_ENVIRONMENT")
+	ghrc.cadenceFilter.States = []DeploymentStatusState{DeploymentStatusStateSuccess}
+	if v := os.Getenv("DORA_CADENCE_STATES"); v != "" {
+		if ghrc.cadenceFilter.States, err = ParseDeploymentStates(v); err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_CADENCE_STATES: %s", err)
+		}
+	}
+
+	return ghrc, nil
+}
+
+// Overrides the team's review profile with the DORA_REVIEW_* environment variables
+func applyReviewOverrides(profile *ReviewProfile) (err error) {
+	if v := os.Getenv("DORA_REVIEW_PICKUP_MINUTES"); v != "" {
+		if profile.PickupMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_REVIEW_PICKUP_MINUTES: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_REVIEW_MINUTES"); v != "" {
+		if profile.ReviewMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_REVIEW_MINUTES: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_REVIEW_CHANGES_REQUESTED_RATE"); v != "" {
+		if profile.ChangesRequestedRate, err = strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("Error parsing DORA_REVIEW_CHANGES_REQUESTED_RATE: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_REVIEWERS_PER_PR"); v != "" {
+		if profile.Reviewers, err = strconv.Atoi(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_REVIEWERS_PER_PR: %s", err)
+		}
+	}
+	return nil
+}
+
+// Creates or fills the target repository so it is ready for generated changes
+func runInit(ctx context.Context, logger *zap.Logger) error {
+	ghrc, err := prepRepoContext(logger)
+	if err != nil {
+		return fmt.Errorf("Error preparing environment: %s", err)
+	}
+
+	environments := os.Getenv("DORA_INIT_ENVIRONMENTS")
+	if environments == "" {
+		environments = "dev"
+	}
+
+	visibility := RepositoryVisibility(strings.ToUpper(os.Getenv("DORA_INIT_VISIBILITY")))
+	switch visibility {
+	case "":
+		visibility = RepositoryVisibilityPrivate
+	case RepositoryVisibilityPrivate, RepositoryVisibilityPublic, RepositoryVisibilityInternal:
+	default:
+		return fmt.Errorf("Unknown repository visibility: %s", visibility)
+	}
+
+	opts := InitOptions{Visibility: visibility}
+	for _, env := range strings.Split(environments, ",") {
+		if env = strings.TrimSpace(env); env != "" {
+			opts.Environments = append(opts.Environments, env)
+		}
+	}
+
+	return ghrc.InitRepository(ctx, logger, opts)
+}
+
+// Overrides the team's idle profile with the DORA_ABANDON_* and DORA_IDLE_*
+// environment variables
+func applyIdleOverrides(profile *IdleProfile) (err error) {
+	if v := os.Getenv("DORA_ABANDON_RATE"); v != "" {
+		if profile.AbandonRate, err = strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("Error parsing DORA_ABANDON_RATE: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_ABANDON_AFTER_MINUTES"); v != "" {
+		if profile.AbandonAfterMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_ABANDON_AFTER_MINUTES: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_IDLE_RATE"); v != "" {
+		if profile.IdleRate, err = strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Errorf("Error parsing DORA_IDLE_RATE: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_IDLE_MINUTES"); v != "" {
+		if profile.IdleMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_IDLE_MINUTES: %s", err)
+		}
+	}
+	if profile.AbandonRate+profile.IdleRate > 100 {
+		return errors.New("DORA_ABANDON_RATE and DORA_IDLE_RATE add up to more than 100")
+	}
+	return nil
+}
+
+// Overrides the team's deploy profile with the DORA_DEPLOY_* environment variables
+func applyDeployOverrides(profile *DeployProfile) (err error) {
+	if v := os.Getenv("DORA_DEPLOY_QUEUED_SECONDS"); v != "" {
+		if profile.QueuedSeconds, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_DEPLOY_QUEUED_SECONDS: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_DEPLOY_MINUTES"); v != "" {
+		if profile.DeployMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_DEPLOY_MINUTES: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_RECOVERY_MINUTES"); v != "" {
+		if profile.RecoveryMinutes, err = ParseRange(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_RECOVERY_MINUTES: %s", err)
+		}
+	}
+	if v := os.Getenv("DORA_DEPLOY_PIPELINE"); v != "" {
+		if profile.Pipeline, err = ParsePipeline(v); err != nil {
+			return fmt.Errorf("Error parsing DORA_DEPLOY_PIPELINE: %s", err)
+		}
+	}
+	return nil
+}
+
+// Loads the sweeper settings. A sweep interval of 0 disables periodic sweeps.
+func loadSweepOptions() (opts SweepOptions, interval time.Duration, err error) {
+	opts.MaxAge = 7 * 24 * time.Hour
+	if v := os.Getenv("DORA_SWEEP_MAX_AGE"); v != "" {
+		if opts.MaxAge, err = time.ParseDuration(v); err != nil {
+			return opts, 0, fmt.Errorf("Error parsing DORA_SWEEP_MAX_AGE: %s", err)
+		}
+	}
+
+	if v := os.Getenv("DORA_SWEEP_INTERVAL"); v != "" {
+		if interval, err = time.ParseDuration(v); err != nil {
+			return opts, 0, fmt.Errorf("Error parsing DORA_SWEEP_INTERVAL: %s", err)
+		}
+	}
+
+	opts.DryRun = strings.ToLower(os.Getenv("DORA_SWEEP_DRY_RUN")) == "true"
+	return opts, interval, nil
+}
+
+// Appends the events of the simulation to DORA_JOURNAL_FILE when it is set.
+// Only the simulation writes the journal, the subcommands read it at most.
+// Returns a function that closes the journal.
+func openJournal(ghrc *GitHubRepoContext) (func() error, error) {
+	path := os.Getenv("DORA_JOURNAL_FILE")
+	if path == "" {
+		return func() error { return nil }, nil
+	}
+	journal, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening journal: %s", err)
+	}
+	ghrc.history.SetJournal(journal)
+	return journal.Close, nil
+}
+
+// Sweeps stale generated pull requests and branches every interval until the
+// context is cancelled. It runs next to the change cycle, which can wait for
+// days between changes.
+func runSweeper(ctx context.Context, logger *zap.Logger, ghrc *GitHubRepoContext, opts SweepOptions, interval time.Duration) {
+	logger.Sugar().Infof("Sweeping stale branches every %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := ghrc.Sweep(ctx, logger, opts); err != nil {
+			ghrc.recordError(PhaseSweep, err)
+			logger.Sugar().Errorf("Error sweeping stale branches: %s", err)
+		}
+	}
+}
+
+// Builds the tracker behind the health, readiness and status endpoints. The
+// change cycle counts as stuck when it is DORA_HEARTBEAT_GRACE late, and the
+// simulator as ready while the target repository can be read.
+func newStatusTracker(ghrc *GitHubRepoContext, doraTeam *DoraTeam, redactor *Redactor) (*StatusTracker, error) {
+	grace := 5 * time.Minute
+	if v := os.Getenv("DORA_HEARTBEAT_GRACE"); v != "" {
+		var err error
+		if grace, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("Error parsing DORA_HEARTBEAT_GRACE: %s", err)
+		}
+	}
+
+	ready := func(ctx context.Context) error {
+		if _, err := getRepoId(ctx, ghrc.client, ghrc.org, ghrc.name); err != nil {
+			return fmt.Errorf("Error reading %s/%s: %s", ghrc.org, ghrc.name, err)
+		}
+		return nil
+	}
+	return NewStatusTracker(doraTeam.Level, ghrc.org+"/"+ghrc.name, grace, ghrc.cadenceFilter, redactor, ready), nil
+}
+
+// Closes and deletes stale generated pull requests and branches once.
+// Pass --dry-run to only list them.
+func runSweep(ctx context.Context, logger *zap.Logger, args []string) error {
+	ghrc, err := prepRepoContext(logger)
+	if err != nil {
+		return fmt.Errorf("Error preparing environment: %s", err)
+	}
+
+	opts, _, err := loadSweepOptions()
+	if err != nil {
+		return err
+	}
+	for _, arg := range args {
+		switch arg {
+		case "--dry-run":
+			opts.DryRun = true
+		default:
+			return fmt.Errorf("Unknown argument: %s", arg)
+		}
+	}
+
+	result, err := ghrc.Sweep(ctx, logger, opts)
+	if err != nil {
+		return err
+	}
+	logger.Sugar().Infof("Swept %d pull request(s) and %d branch(es)", len(result.ClosedPullRequests), len(result.DeletedBranches))
+	return nil
+}
+
+// Calculates the DORA metrics of the simulation over rolling windows and shows
+// how they compare to the configured team level
+func runMetrics(ctx context.Context, logger *zap.Logger, args []string) error {
+	ghrc, doraTeam, err := prepEnvironment(logger)
+	if err != nil {
+		return fmt.Errorf("Error preparing environment: %s", err)
+	}
+
+	windows := []time.Duration{7 * 24 * time.Hour, 30 * 24 * time.Hour, 90 * 24 * time.Hour}
+	environment := ghrc.cadenceFilter.Environment
+	customWindows := false
+	for i := 0; i < len(args); i++ {
+		if i+1 >= len(args) {
+			return fmt.Errorf("Missing value for %s", args[i])
+		}
+		switch args[i] {
+		case "--window":
+			window, err := time.ParseDuration(args[i+1])
+			if err != nil || window <= 0 {
+				return fmt.Errorf("Invalid window %q", args[i+1])
+			}
+			if !customWindows {
+				windows, customWindows = nil, true
+			}
+			windows = append(windows, window)
+		case "--environment":
+			environment = args[i+1]
+		default:
+			return fmt.Errorf("Unknown argument: %s", args[i])
+		}
+		i++
+	}
+
+	now := time.Now()
+	sources := map[string][]Event{}
+	if path := os.Getenv("DORA_JOURNAL_FILE"); path != "" {
+		if sources[MetricsSourceJournal], err = readJournalFile(path, environment); err != nil {
+			return err
+		}
+	}
+	if sources[MetricsSourceGitHub], err = ghrc.DeploymentEvents(ctx, environment, now.Add(-slices.Max(windows))); err != nil {
+		return err
+	}
+
+	for _, source := range []string{MetricsSourceJournal, MetricsSourceGitHub} {
+		events, ok := sources[source]
+		if !ok {
+			continue
+		}
+		var metrics []DoraMetrics
+		for _, window := range windows {
+			metrics = append(metrics, CalculateMetrics(events, window, now))
+		}
+		if err := WriteMetricsReport(os.Stdout, doraTeam.Level, source, metrics); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Runs one change through the team's lifecycle, from waiting for its
+// scheduled time to its deployment, as a single trace. A minutesUntilNextDeploy
+// of -1 means no new change is due. When a pending pull request is due before
+// the next change it is carried on with instead. Pull requests that are to be
+// abandoned or sit idle are added to pending, so the loop does not wait for
+// them. Changes that are abandoned, fail their checks or fail to deploy end the
+// cycle without an error; errors stop the simulator.
+func runChangeCycle(ctx context.Context, logger *zap.Logger, ghrc *GitHubRepoContext, doraTeam *DoraTeam, minutesUntilNextDeploy int, pending *PendingChanges) (err error) {
+	ctx, span := tracer.Start(ctx, "change cycle", trace.WithAttributes(
+		attrRepository.String(ghrc.org+"/"+ghrc.name),
+		attrTeamLevel.String(doraTeam.Level)))
+	defer func() { endSpan(span, err) }()
+	ghrc.cicdEvents.SetSpan(span)
+	defer ghrc.cicdEvents.SetSpan(nil)
+	defer ghrc.status.SetPhase(PhaseIdle)
+
+	// Wait for the next change, or the first pending pull request due before it
+	var wakeAt time.Time
+	if minutesUntilNextDeploy != -1 {
+		logger.Sugar().Infof("Minutes until next deployment: %d", minutesUntilNextDeploy)
+		wakeAt = time.Now().Add(time.Duration(minutesUntilNextDeploy) * time.Minute)
+		ghrc.metrics.SetNextDeploy(wakeAt)
+		ghrc.status.SetNextDeploy(wakeAt)
+	}
+	if due, ok := pending.NextDue(); ok && (wakeAt.IsZero() || due.Before(wakeAt)) {
+		wakeAt = due
+	}
+	ghrc.status.SetPhase(PhaseSchedule)
+	_, waitSpan := tracer.Start(ctx, "wait for schedule")
+	err = ghrc.sleep(ctx, time.Until(wakeAt))
+	waitSpan.End()
+	if err != nil {
+		return err
+	}
+
+	if c := pending.PopDue(time.Now()); c != nil {
+		span.SetAttributes(attrPRNumber.Int(c.PRNumber))
+		ghrc.history.StartChange(c.Data)
+		open, err := ghrc.PendingStillOpen(ctx, logger, c)
+		if err != nil {
+			ghrc.recordError(PhaseSchedule, err)
+			return err
+		}
+		if !open {
+			return nil
+		}
+		if c.Fate == PullRequestFateAbandon {
+			ghrc.status.SetPhase(PhaseAbandon)
+			if err := ghrc.ClosePullRequest(ctx, logger, c.PRNumber); err != nil {
+				ghrc.recordError(PhaseAbandon, err)
+				return fmt.Errorf("Error abandoning PR: %s", err)
+			}
+			ghrc.history.Record(Event{Type: EventPRAbandoned, PRNumber: c.PRNumber, Detail: c.Delay.String()})
+			return nil
+		}
+		logger.Sugar().Infof("Picking up PR %d after it sat idle", c.PRNumber)
+		return completeChange(ctx, logger, span, ghrc, doraTeam, c.PRNumber, c.PRId, c.Persona, c.Data)
+	}
+
+	logger.Sugar().Info("Creating deployment")
+	ghrc.status.SetPhase(PhaseGenerate)
+	persona := ghrc.personas.Sample(time.Now())
+	changeData := ghrc.templates.NewChangeData(doraTeam)
+	changeData.Author = persona.Name
+	ghrc.history.StartChange(changeData)
+	pullRequest, err := ghrc.GeneratePullRequest(ctx, logger, changeData, persona)
+	if err != nil {
+		ghrc.recordError(PhaseGenerate, err)
+		return fmt.Errorf("Error generating deployment: %s", err)
+	}
+
+	prNumber := pullRequest.CreatePullRequest.PullRequest.Number
+	prId := pullRequest.CreatePullRequest.PullRequest.Id
+	span.SetAttributes(attrPRNumber.Int(prNumber))
+
+	// Abandon the PR, or leave it idle before anyone works on it
+	fate, delay := doraTeam.Idle.SampleFate()
+	switch fate {
+	case PullRequestFateAbandon:
+		logger.Sugar().Infof("PR %d will be abandoned in %s", prNumber, delay)
+	case PullRequestFateIdle:
+		logger.Sugar().Infof("PR %d will sit idle for %s", prNumber, delay)
+		ghrc.history.Record(Event{Type: EventPRIdle, PRNumber: prNumber, Detail: delay.String()})
+	default:
+		return completeChange(ctx, logger, span, ghrc, doraTeam, prNumber, prId, persona, changeData)
+	}
+	pending.Add(&PendingChange{
+		Fate:     fate,
+		Due:      time.Now().Add(delay),
+		Delay:    delay,
+		PRNumber: prNumber,
+		PRId:     prId,
+		Persona:  persona,
+		Data:     changeData,
+	})
+	return nil
+}
+
+// Reviews, merges and deploys the pull request of a change
+func completeChange(
+	ctx context.Context,
+	logger *zap.Logger,
+	span trace.Span,
+	ghrc *GitHubRepoContext,
+	doraTeam *DoraTeam,
+	prNumber int,
+	prId string,
+	persona *Persona,
+	changeData *ChangeTemplateData) (err error) {
+
+	// Review the PR
+	ghrc.status.SetPhase(PhaseReview)
+	err = ghrc.SimulateReview(ctx, logger, doraTeam.Review, prNumber, persona, changeData)
+	if err != nil {
+		ghrc.recordError(PhaseReview, err)
+		return fmt.Errorf("Error reviewing PR: %s", err)
+	}
+
+	// Merge the PR
+	ghrc.status.SetPhase(PhaseMerge)
+	mergeMethod := doraTeam.SampleMergeMethod()
+	mergeCtx, mergeSpan := tracer.Start(ctx, "merge", trace.WithAttributes(attrPRNumber.Int(prNumber)))
+	mergeSha, err := ghrc.MergeWithMode(mergeCtx, logger, prId, prNumber, mergeMethod, persona, changeData)
+	endSpan(mergeSpan, err)
+	if errors.Is(err, ErrStatusChecksFailed) {
+		logger.Sugar().Infof("Change was not merged: %s", err)
+		return nil
+	}
+	if err != nil {
+		ghrc.recordError(PhaseMerge, err)
+		return fmt.Errorf("Error merging PR: %s", err)
+	}
+
+	span.SetAttributes(attrSHA.String(mergeSha))
+	ghrc.history.Record(Event{Type: EventMerged, PRNumber: prNumber, SHA: mergeSha, Detail: string(mergeMethod)})
+
+	if ghrc.deleteHeadBranch {
+		ghrc.status.SetPhase(PhaseCleanup)
+		if err := ghrc.DeleteHeadBranch(ctx, logger, prNumber); err != nil {
+			ghrc.recordError(PhaseCleanup, err)
+			logger.Sugar().Errorf("Error deleting head branch: %s", err)
+		}
+	}
+
+	// Deploy the change, or wait for the deploy workflow to
+	ghrc.status.SetPhase(PhaseDeploy)
+	deployCtx, deploySpan := tracer.Start(ctx, "deploy", trace.WithAttributes(attrSHA.String(mergeSha)))
+	deployStart := time.Now()
+	if ghrc.deployMode == DeployModeAPI {
+		err = ghrc.DeployPipeline(deployCtx, logger, mergeSha, doraTeam.Deploy, changeData)
+	} else {
+		ghrc.history.Record(Event{Type: EventDeployStarted, SHA: mergeSha})
+		err = ghrc.WaitForDeployment(deployCtx, mergeSha)
+		if err == nil || errors.Is(err, ErrDeploymentFailed) {
+			ghrc.recordDeployResult("", mergeSha, time.Now(), err != nil)
+		}
+	}
+	endSpan(deploySpan, err)
+	ghrc.metrics.ObserveDeployWait(time.Since(deployStart))
+	var failure *DeploymentError
+	if errors.As(err, &failure) && ghrc.recoveryStrategy == RecoveryStrategyRollback {
+		logger.Sugar().Infof("Deployment failed: %s", err)
+		ghrc.status.SetPhase(PhaseRollback)
+		rollbackCtx, rollbackSpan := tracer.Start(ctx, "rollback")
+		err = ghrc.Rollback(rollbackCtx, logger, failure, doraTeam.Deploy, changeData)
+		endSpan(rollbackSpan, err)
+		if err != nil {
+			ghrc.recordError(PhaseRollback, err)
+			return fmt.Errorf("Error rolling back: %s", err)
+		}
+		return nil
+	}
+	if errors.Is(err, ErrDeploymentFailed) {
+		logger.Sugar().Infof("Deployment of %s failed: %s", mergeSha, err)
+		return nil
+	}
+	if errors.Is(err, ErrDeployCheckNotFound) {
+		ghrc.recordError(PhaseDeploy, err)
+		return fmt.Errorf("No deploy check found, check DORA_DEPLOY_CHECKS: %s", err)
+	}
+	if err != nil {
+		ghrc.recordError(PhaseDeploy, err)
+		return fmt.Errorf("Error waiting for deployment: %s", err)
+	}
+	logger.Sugar().Info("Deployment complete")
+	return nil
+}
+
+// Serves webhooks on DORA_WEBHOOK_ADDR, Prometheus metrics on
+// DORA_METRICS_ADDR and the health, readiness and status endpoints on
+// DORA_HEALTH_ADDR, sharing one server between the same addresses
+func startHTTPServers(logger *zap.Logger, ghrc *GitHubRepoContext) {
+	muxes := map[string]*http.ServeMux{}
+	handle := func(addr string, pattern string, handler http.Handler) {
+		if muxes[addr] == nil {
+			muxes[addr] = http.NewServeMux()
+		}
+		muxes[addr].Handle(pattern, handler)
+		logger.Sugar().Infof("Serving %s on %s", pattern, addr)
+	}
+
+	if ghrc.webhooks != nil {
+		handle(os.Getenv("DORA_WEBHOOK_ADDR"), "/webhook", ghrc.webhooks)
+	}
+	if addr := os.Getenv("DORA_METRICS_ADDR"); addr != "" {
+		handle(addr, "/metrics", ghrc.metrics.Handler())
+	}
+	if addr := os.Getenv("DORA_HEALTH_ADDR"); addr != "" {
+		status := ghrc.status.Handler()
+		for _, pattern := range []string{"/healthz", "/readyz", "/status"} {
+			handle(addr, pattern, status)
+		}
+	}
+
+	for addr, mux := range muxes {
+		server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := server.ListenAndServe(); err != nil {
+				logger.Sugar().Errorf("Error serving HTTP on %s: %s", addr, err)
+			}
+		}()
+	}
 }
 
 func main() {
 	ctx := context.Background()
-	ghrc, doraTeam, err := prepEnvironment()
+
+	redactor := NewRedactor()
+	redactor.AddSecrets(secretsFromEnv()...)
+	logger, err := NewLogger(redactor)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logging: %s\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync() //nolint:errcheck // Nothing left to report a failed flush to
+
+	if len(os.Args) > 1 && os.Args[1] == "init" {
+		if err := runInit(ctx, logger); err != nil {
+			logger.Sugar().Errorf("Error initializing repository: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "sweep" {
+		if err := runSweep(ctx, logger, os.Args[2:]); err != nil {
+			logger.Sugar().Errorf("Error sweeping repository: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "metrics" {
+		if err := runMetrics(ctx, logger, os.Args[2:]); err != nil {
+			logger.Sugar().Errorf("Error calculating metrics: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	ghrc, doraTeam, err := prepEnvironment(logger)
+	if err != nil {
+		logger.Sugar().Errorf("Error preparing environment: %s", err)
+		return
+	}
+
+	closeJournal, err := openJournal(ghrc)
 	if err != nil {
 		logger.Sugar().Errorf("Error preparing environment: %s", err)
 		return
 	}
+	defer func() {
+		if err := closeJournal(); err != nil {
+			logger.Sugar().Errorf("Error closing journal: %s", err)
+		}
+	}()
 
 	logger.Sugar().Infof("Dora team performance level: %s", doraTeam.Level)
 
+	if ghrc.status, err = newStatusTracker(ghrc, doraTeam, redactor); err != nil {
+		logger.Sugar().Errorf("Error preparing environment: %s", err)
+		return
+	}
+	ghrc.history.AddObserver(ghrc.status.Observe)
+
+	shutdownTracing, err := initTracing(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("Error initializing tracing: %s", err)
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Sugar().Errorf("Error flushing traces: %s", err)
+		}
+	}()
+
+	startHTTPServers(logger, ghrc)
+
+	sweepOptions, sweepInterval, err := loadSweepOptions()
+	if err != nil {
+		logger.Sugar().Errorf("Error preparing environment: %s", err)
+		return
+	}
+	pending := &PendingChanges{}
+	sweepOptions.Keep = pending.Has
+	if sweepInterval > 0 {
+		go runSweeper(ctx, logger, ghrc, sweepOptions, sweepInterval)
+	}
+
 	for {
+		ghrc.status.Heartbeat()
 		minutesUntilNextDeploy, err := doraTeam.MinutesUntilNextDeployment(ctx, ghrc)
 		if err != nil {
+			ghrc.recordError(PhaseSchedule, err)
 			logger.Sugar().Errorf("Error calculating minutes until next deployment: %s", err)
 			return
 		}
-		if minutesUntilNextDeploy != -1 {
-			// Generate a deployment
-			logger.Sugar().Infof("Minutes until next deployment: %d", minutesUntilNextDeploy)
-			t := time.NewTicker(time.Duration(minutesUntilNextDeploy) * time.Minute)
-			<-t.C // wait for the next deployment time
-
-			logger.Sugar().Info("Creating deployment")
-			pullRequest, err := ghrc.GeneratePullRequest(ctx, logger)
-			if err != nil {
-				logger.Sugar().Errorf("Error generating deployment: %s", err)
-				return
-			}
-
-			// Wait for status checks to complete
-			prNumber := pullRequest.CreatePullRequest.PullRequest.Number
-			err = ghrc.WaitForStatusChecks(ctx, prNumber)
-			if err != nil {
-				logger.Sugar().Errorf("Error waiting for status checks: %s", err)
-				return
-			}
-			logger.Sugar().Info("Status checks complete")
-
-			// Merge the PR
-			mergeResponse, err := mergePullRequest(ctx, ghrc.client, pullRequest.CreatePullRequest.PullRequest.Id)
-			if err != nil {
-				logger.Sugar().Errorf("Error merging PR: %s", err)
-				return
-			}
-			logger.Sugar().Infof("Merged Response merge sha: %s", mergeResponse.MergePullRequest.PullRequest.MergeCommit.Oid)
-
-			// Wait for deployment to complete
-			err = ghrc.WaitForDeployment(ctx, mergeResponse.MergePullRequest.PullRequest.MergeCommit.Oid)
-			if err != nil {
-				logger.Sugar().Errorf("Error waiting for deployment: %s", err)
+		if due, ok := pending.NextDue(); minutesUntilNextDeploy != -1 || (ok && !due.After(time.Now())) {
+			if err := runChangeCycle(ctx, logger, ghrc, doraTeam, minutesUntilNextDeploy, pending); err != nil {
+				logger.Sugar().Error(err)
 				return
 			}
-			logger.Sugar().Info("Deployment complete")
 		} else {
 			logger.Sugar().Infof("Last deploy was before %d minutes... skipping", doraTeam.MinutesBetweenDeployRange.LowerBound)
 			time.Sleep(5 * time.Second)
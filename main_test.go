@@ -1,8 +1,15 @@
 package main
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"go.uber.org/zap"
 )
 
 func TestPrepEnvironmentSuccessful(t *testing.T) {
@@ -22,7 +29,7 @@ func TestPrepEnvironmentSuccessful(t *testing.T) {
 		os.Unsetenv("GH_REPO_NAME")
 	}()
 
-	ghrc, doraTeam, err := prepEnvironment()
+	ghrc, doraTeam, err := prepEnvironment(zap.NewNop())
 	if err != nil {
 		t.Errorf("Error preparing: %s", err)
 	}
@@ -47,3 +54,127 @@ func TestPrepEnvironmentSuccessful(t *testing.T) {
 		t.Errorf("Expected doraTeam.Level to be Elite, got %s", doraTeam.Level)
 	}
 }
+
+// Serves canned GraphQL responses keyed by operation name and records the
+// variables each operation was called with. Operations in pages are answered
+// with one response per call, in order, to fake pagination.
+type fakeGitHub struct {
+	responses map[string]string
+	pages     map[string][]string
+	calls     map[string]map[string]interface{}
+	counts    map[string]int
+}
+
+func newFakeGitHub(t *testing.T, responses map[string]string) (*fakeGitHub, *GitHubRepoContext) {
+	fake := &fakeGitHub{responses: responses, pages: map[string][]string{}, calls: map[string]map[string]interface{}{}, counts: map[string]int{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OperationName string                 `json:"operationName"`
+			Variables     map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Error decoding request: %s", err)
+		}
+		fake.calls[req.OperationName] = req.Variables
+		fake.counts[req.OperationName]++
+
+		response, ok := fake.responses[req.OperationName]
+		if pages := fake.pages[req.OperationName]; len(pages) > 0 {
+			response, ok = pages[min(fake.counts[req.OperationName], len(pages))-1], true
+		}
+		if !ok {
+			t.Errorf("Unexpected operation: %s", req.OperationName)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":` + response + `}`))
+	}))
+	t.Cleanup(server.Close)
+
+	ghrc := &GitHubRepoContext{
+		pat:        "test-pat",
+		org:        "test-org",
+		name:       "test-repo",
+		graphqlUrl: server.URL,
+		logger:     zap.NewNop(),
+		templates:  &ChangeTemplates{BranchPrefix: "dora-the-explorer-"},
+	}
+	ghrc.client = ghrc.generateClient(server.URL)
+	return fake, ghrc
+}
+
+func TestPrepEnvironmentCICDEventsRequireTracing(t *testing.T) {
+	t.Setenv("GH_PAT", "test-pat")
+	t.Setenv("GH_ORG", "test-org")
+	t.Setenv("GH_GRAPHQL_URL", "test-graphql-url")
+	t.Setenv("GH_BASE_URL", "test-base-url")
+	t.Setenv("GH_REPO_NAME", "test-repo-name")
+	t.Setenv("DORA_TEAM_PERFORMANCE_LEVEL", "elite")
+	t.Setenv("DORA_CICD_EVENTS", "true")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	if _, _, err := prepEnvironment(zap.NewNop()); err == nil {
+		t.Errorf("Expected DORA_CICD_EVENTS without tracing to be rejected")
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	ghrc, _, err := prepEnvironment(zap.NewNop())
+	if err != nil {
+		t.Fatalf("Error preparing: %s", err)
+	}
+	if ghrc.cicdEvents == nil {
+		t.Errorf("Expected CI/CD events to be emitted")
+	}
+}
+
+func TestNegativeCheckFailureRetries(t *testing.T) {
+	t.Setenv("GH_PAT", "test-pat")
+	t.Setenv("GH_ORG", "test-org")
+	t.Setenv("GH_GRAPHQL_URL", "test-graphql-url")
+	t.Setenv("GH_BASE_URL", "test-base-url")
+	t.Setenv("GH_REPO_NAME", "test-repo-name")
+	t.Setenv("DORA_CHECK_FAILURE_RETRIES", "-1")
+
+	if _, err := prepRepoContext(zap.NewNop()); err == nil {
+		t.Errorf("Expected an error for negative check failure retries")
+	} else if !strings.Contains(err.Error(), "DORA_CHECK_FAILURE_RETRIES") {
+		t.Errorf("Expected DORA_CHECK_FAILURE_RETRIES to be rejected, got %s", err)
+	}
+}
+
+func TestJournalOnlyOpenedBySimulation(t *testing.T) {
+	t.Setenv("GH_PAT", "test-pat")
+	t.Setenv("GH_ORG", "test-org")
+	t.Setenv("GH_GRAPHQL_URL", "test-graphql-url")
+	t.Setenv("GH_BASE_URL", "test-base-url")
+	t.Setenv("GH_REPO_NAME", "test-repo-name")
+	t.Setenv("DORA_TEAM_PERFORMANCE_LEVEL", "elite")
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	t.Setenv("DORA_JOURNAL_FILE", path)
+
+	// Subcommands such as metrics prepare the environment without writing
+	ghrc, _, err := prepEnvironment(zap.NewNop())
+	if err != nil {
+		t.Fatalf("Error preparing: %s", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the journal not to be opened, got %v", err)
+	}
+
+	closeJournal, err := openJournal(ghrc)
+	if err != nil {
+		t.Fatalf("Error opening journal: %s", err)
+	}
+	ghrc.history.Record(Event{Type: EventPROpened, PRNumber: 1})
+	if err := closeJournal(); err != nil {
+		t.Fatalf("Error closing journal: %s", err)
+	}
+
+	events, err := readJournalFile(path, "")
+	if err != nil {
+		t.Fatalf("Error reading journal: %s", err)
+	}
+	if len(events) != 1 || events[0].PRNumber != 1 {
+		t.Errorf("Expected the event to be journaled, got %v", events)
+	}
+}
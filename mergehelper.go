@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// How many times a pull request is brought up to date with its base branch
+// before giving up on merging it
+const maxBranchUpdates = 3
+
+// Returned by WaitForMerge, along with ErrStatusChecksFailed, when the pull
+// request was closed while waiting for it to be merged
+var errPullRequestClosed = errors.New("pull request was closed")
+
+// Returned by WaitForMerge when an auto-merge pull request is behind or
+// conflicts with its base branch, which GitHub will not merge it in
+var errPullRequestOutOfDate = errors.New("pull request is out of date with its base branch")
+
+const (
+	MergeModeDirect = "direct" // Wait for status checks then call mergePullRequest
+	MergeModeAuto   = "auto"   // Enable auto-merge and wait for GitHub to merge
+	MergeModeQueue  = "queue"  // Add the pull request to the merge queue
+)
+
+// Parses a weighted list of merge methods, for example "squash:3,merge:1"
+func ParseMergeMethods(s string) ([]Weighted[PullRequestMergeMethod], error) {
+	choices, err := ParseWeightedList(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []Weighted[PullRequestMergeMethod]
+	for _, c := range choices {
+		method := PullRequestMergeMethod(strings.ToUpper(c.Value))
+		switch method {
+		case PullRequestMergeMethodMerge, PullRequestMergeMethodSquash, PullRequestMergeMethodRebase:
+		default:
+			return nil, fmt.Errorf("Unknown merge method: %s", c.Value)
+		}
+		methods = append(methods, Weighted[PullRequestMergeMethod]{Value: method, Weight: c.Weight})
+	}
+	return methods, nil
+}
+
+// Returns the commit the deployment runs against after a merge. Merge and
+// squash merges create a single merge commit. A rebase merge replays every
+// commit of the pull request onto the base branch, and GitHub reports the last
+// of them, which the push that triggers the deploy ends at, as the merge
+// commit. The head of the base branch is not used, since later merges may
+// have moved it by the time it is read.
+func deployShaForMerge(mergeCommitOid string) (string, error) {
+	if mergeCommitOid == "" {
+		return "", errors.New("Merge did not return a merge commit")
+	}
+	return mergeCommitOid, nil
+}
+
+// Merges the pull request and returns the SHA to track the deployment of.
+//
+// In MergeModeDirect, the default, this is MergeWhenReady. MergeModeAuto
+// enables auto-merge with the given method and MergeModeQueue adds the pull
+// request to the merge queue, which picks its own method. Both then wait for
+// GitHub to merge the pull request, applying the check failure policy when its
+// checks fail on the way. When a fix makes the checks pass again the pull
+// request is handed back to GitHub.
+//
+// Returns an error wrapping ErrStatusChecksFailed when the pull request was
+// not merged because of its checks, or because it was closed.
+func (ghrc *GitHubRepoContext) MergeWithMode(
+	ctx context.Context,
+	logger *zap.Logger,
+	prId string,
+	prNumber int,
+	method PullRequestMergeMethod,
+	author *Persona,
+	data *ChangeTemplateData) (string, error) {
+
+	if ghrc.mergeMode != MergeModeAuto && ghrc.mergeMode != MergeModeQueue {
+		return ghrc.MergeWhenReady(ctx, logger, prId, prNumber, method, author, data)
+	}
+
+	request := true
+	for attempt := 0; ; attempt++ {
+		if request {
+			sha, err := ghrc.requestMerge(ctx, logger, prId, prNumber, method)
+			if err != nil || sha != "" {
+				return sha, err
+			}
+		}
+		request = true
+
+		sha, err := ghrc.WaitForMerge(ctx, prNumber)
+		switch {
+		case errors.Is(err, errPullRequestOutOfDate):
+			// Auto-merge stays enabled while the branch is brought up to date
+			if _, err := ghrc.UpdateBaseBranch(ctx, logger, prNumber, author, data); err != nil {
+				return "", err
+			}
+			request = false
+		case errors.Is(err, ErrStatusChecksFailed) && !errors.Is(err, errPullRequestClosed):
+			logger.Sugar().Infof("PR %d was not merged: %s", prNumber, err)
+			if err := ghrc.HandleFailedChecks(ctx, logger, prNumber, author, data); err != nil {
+				return "", err
+			}
+		default:
+			return sha, err
+		}
+		if attempt >= maxBranchUpdates {
+			return "", fmt.Errorf("PR %d was still not merged after %d attempts", prNumber, maxBranchUpdates)
+		}
+	}
+}
+
+// Enables auto-merge or adds the pull request to the merge queue, depending on
+// the merge mode. GitHub refuses auto-merge for a pull request that can be
+// merged right away, as in a repository without required checks, so a clean
+// one is merged instead and the SHA to track the deployment of returned.
+func (ghrc *GitHubRepoContext) requestMerge(ctx context.Context, logger *zap.Logger, prId string, prNumber int, method PullRequestMergeMethod) (string, error) {
+	if ghrc.mergeMode == MergeModeQueue {
+		entry, err := enqueuePullRequest(ctx, ghrc.client, prId)
+		if err != nil {
+			return "", fmt.Errorf("Error adding PR to the merge queue: %s", err)
+		}
+		logger.Sugar().Infof("PR %d is at position %d in the merge queue", prNumber, entry.EnqueuePullRequest.MergeQueueEntry.Position)
+		return "", nil
+	}
+
+	_, err := enablePullRequestAutoMerge(ctx, ghrc.client, prId, method)
+	if err == nil {
+		logger.Sugar().Infof("Enabled auto-merge with %s for PR %d", method, prNumber)
+		return "", nil
+	}
+	pr, mergeabilityErr := ghrc.waitForMergeability(ctx, prNumber)
+	if mergeabilityErr != nil || pr.MergeStateStatus != MergeStateStatusClean {
+		return "", fmt.Errorf("Error enabling auto-merge: %s", err)
+	}
+	logger.Sugar().Infof("PR %d can be merged right away, merging instead of enabling auto-merge", prNumber)
+	return ghrc.MergePullRequest(ctx, logger, prId, method)
+}
+
+// Waits for the status checks to pass and the pull request to be up to date
+// with its base branch, then merges it. If the merge fails because the base
+// branch moved in the meantime the pull request is updated and merged again.
+//
+// Returns an error wrapping ErrStatusChecksFailed when the checks failed and
+// the check failure policy has been applied.
+func (ghrc *GitHubRepoContext) MergeWhenReady(
+	ctx context.Context,
+	logger *zap.Logger,
+	prId string,
+	prNumber int,
+	method PullRequestMergeMethod,
+	author *Persona,
+	data *ChangeTemplateData) (string, error) {
+
+	if err := ghrc.PrepareForMerge(ctx, logger, prNumber, author, data); err != nil {
+		return "", err
+	}
+
+	sha, err := ghrc.MergePullRequest(ctx, logger, prId, method)
+	if err == nil {
+		return sha, nil
+	}
+
+	logger.Sugar().Warnf("Error merging PR %d, updating it and retrying: %s", prNumber, err)
+	if err := ghrc.PrepareForMerge(ctx, logger, prNumber, author, data); err != nil {
+		return "", err
+	}
+	return ghrc.MergePullRequest(ctx, logger, prId, method)
+}
+
+// Waits for the status checks, applying the check failure policy if they fail,
+// and brings the pull request up to date with its base branch. Updating the
+// branch runs the checks again, so this repeats up to maxBranchUpdates times.
+func (ghrc *GitHubRepoContext) PrepareForMerge(
+	ctx context.Context,
+	logger *zap.Logger,
+	prNumber int,
+	author *Persona,
+	data *ChangeTemplateData) error {
+
+	for update := 0; ; update++ {
+		err := ghrc.WaitForStatusChecks(ctx, prNumber)
+		if errors.Is(err, ErrStatusChecksFailed) {
+			err = ghrc.HandleFailedChecks(ctx, logger, prNumber, author, data)
+		}
+		if err != nil {
+			return err
+		}
+		logger.Sugar().Info("Status checks complete")
+		ghrc.history.Record(Event{Type: EventChecksPassed, PRNumber: prNumber})
+
+		updated, err := ghrc.UpdateBaseBranch(ctx, logger, prNumber, author, data)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return nil
+		}
+		if update >= maxBranchUpdates {
+			return fmt.Errorf("PR %d is still out of date after %d updates", prNumber, maxBranchUpdates)
+		}
+	}
+}
+
+// Merges the pull request with the given method and returns the SHA to track
+// the deployment of.
+func (ghrc *GitHubRepoContext) MergePullRequest(ctx context.Context, logger *zap.Logger, prId string, method PullRequestMergeMethod) (string, error) {
+	mergeResponse, err := mergePullRequest(ctx, ghrc.client, prId, method)
+	if err != nil {
+		return "", err
+	}
+
+	sha, err := deployShaForMerge(mergeResponse.MergePullRequest.PullRequest.MergeCommit.Oid)
+	if err != nil {
+		return "", err
+	}
+	logger.Sugar().Infof("Merged with %s, deploy sha: %s", method, sha)
+
+	return sha, nil
+}
+
+// This function will wait for up to 30 minutes for GitHub to merge the pull
+// request, through auto-merge or a merge queue. Returns the SHA to track the
+// deployment of.
+//
+// Returns an error wrapping ErrStatusChecksFailed as soon as the checks of the
+// pull request fail, it is removed from the merge queue, or it is closed,
+// since GitHub will not merge it then.
+func (ghrc *GitHubRepoContext) WaitForMerge(ctx context.Context, prNumber int) (string, error) {
+	ghrc.logger.Sugar().Infof("Waiting for PR %d to be merged", prNumber)
+	timeout := time.After(30 * time.Minute)
+	tick := time.Tick(10 * time.Second)
+	wake, unsubscribe := ghrc.webhooks.Subscribe(pullRequestKey(prNumber))
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-timeout:
+			return "", fmt.Errorf("Timed out after 30 minutes waiting for PR %d to be merged", prNumber)
+		case <-tick:
+		case <-wake:
+		}
+		ghrc.status.Heartbeat()
+
+		resp, err := getPullRequestMergeState(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+		if err != nil {
+			return "", err
+		}
+
+		pr := resp.Repository.PullRequest
+		switch pr.State {
+		case PullRequestStateMerged:
+			return deployShaForMerge(pr.MergeCommit.Oid)
+		case PullRequestStateClosed:
+			ghrc.history.Record(Event{Type: EventPRClosed, PRNumber: prNumber, Detail: "closed while waiting to be merged"})
+			return "", fmt.Errorf("PR %d was closed without being merged: %w: %w", prNumber, errPullRequestClosed, ErrStatusChecksFailed)
+		case PullRequestStateOpen:
+			switch pr.StatusCheckRollup.State {
+			case "FAILURE", "ERROR":
+				return "", fmt.Errorf("PR %d failed its checks while waiting to be merged: %w", prNumber, ErrStatusChecksFailed)
+			}
+			// A pull request leaves the queue when its merge group fails
+			if ghrc.mergeMode == MergeModeQueue && !pr.IsInMergeQueue {
+				return "", fmt.Errorf("PR %d was removed from the merge queue: %w", prNumber, ErrStatusChecksFailed)
+			}
+			if ghrc.mergeMode == MergeModeAuto && (pr.MergeStateStatus == MergeStateStatusBehind || pr.MergeStateStatus == MergeStateStatusDirty) {
+				return "", fmt.Errorf("PR %d: %w", prNumber, errPullRequestOutOfDate)
+			}
+			continue
+		default:
+			return "", fmt.Errorf("Unknown pull request state: %s", pr.State)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestParseMergeMethods(t *testing.T) {
+	methods, err := ParseMergeMethods("squash:3,Merge,rebase:0")
+	if err != nil {
+		t.Fatalf("Error parsing merge methods: %s", err)
+	}
+	if len(methods) != 3 || methods[1].Value != PullRequestMergeMethodMerge {
+		t.Errorf("Unexpected merge methods: %v", methods)
+	}
+
+	if _, err := ParseMergeMethods("fast-forward"); err == nil {
+		t.Errorf("Expected an error for an unknown merge method")
+	}
+}
+
+func TestMergePullRequestDeploySha(t *testing.T) {
+	response := `{"mergePullRequest":{"pullRequest":{"merged":true,"mergedAt":"2024-01-01T00:00:00Z","mergeCommit":{"oid":"merge-sha"}}}}`
+
+	tests := []struct {
+		method PullRequestMergeMethod
+		sha    string
+	}{
+		{PullRequestMergeMethodSquash, "merge-sha"},
+		{PullRequestMergeMethodMerge, "merge-sha"},
+		{PullRequestMergeMethodRebase, "merge-sha"},
+	}
+	for _, tt := range tests {
+		fake, ghrc := newFakeGitHub(t, map[string]string{"mergePullRequest": response})
+
+		sha, err := ghrc.MergePullRequest(context.Background(), zap.NewNop(), "PR_1", tt.method)
+		if err != nil {
+			t.Fatalf("Error merging with %s: %s", tt.method, err)
+		}
+		if sha != tt.sha {
+			t.Errorf("Expected %s deploy sha to be %s, got %s", tt.method, tt.sha, sha)
+		}
+		if fake.calls["mergePullRequest"]["mergeMethod"] != string(tt.method) {
+			t.Errorf("Expected merge method %s, got %v", tt.method, fake.calls["mergePullRequest"]["mergeMethod"])
+		}
+	}
+}
+
+func TestDeployShaForMerge(t *testing.T) {
+	if sha, err := deployShaForMerge("merge-sha"); err != nil || sha != "merge-sha" {
+		t.Errorf("Expected the merge commit, got %s, %v", sha, err)
+	}
+	if _, err := deployShaForMerge(""); err == nil {
+		t.Errorf("Expected an error without a merge commit")
+	}
+}
+
+// The merge state of PR 7 as returned by getPullRequestMergeState
+func mergeState(state string, inQueue bool, rollup string) string {
+	return fmt.Sprintf(`{"repository":{"pullRequest":{"state":%q,"merged":%t,"mergedAt":null,"isInMergeQueue":%t,`+
+		`"statusCheckRollup":{"state":%q},"mergeCommit":{"oid":"merge-sha"}}}}`,
+		state, state == "MERGED", inQueue, rollup)
+}
+
+func TestMergeWithModeAuto(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"enablePullRequestAutoMerge": `{"enablePullRequestAutoMerge":{"pullRequest":{"id":"PR_7"}}}`,
+	})
+	fake.pages["getPullRequestMergeState"] = []string{mergeState("OPEN", false, "PENDING"), mergeState("MERGED", false, "SUCCESS")}
+	ghrc.mergeMode = MergeModeAuto
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	sha, err := ghrc.MergeWithMode(context.Background(), zap.NewNop(), "PR_7", 7, PullRequestMergeMethodSquash, &defaultPersona, &ChangeTemplateData{})
+	if err != nil {
+		t.Fatalf("Error merging: %s", err)
+	}
+	if sha != "merge-sha" {
+		t.Errorf("Expected deploy sha merge-sha, got %s", sha)
+	}
+	if fake.calls["enablePullRequestAutoMerge"]["mergeMethod"] != string(PullRequestMergeMethodSquash) {
+		t.Errorf("Expected auto-merge with SQUASH, got %v", fake.calls["enablePullRequestAutoMerge"])
+	}
+}
+
+func TestMergeWithModeAutoClean(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"enablePullRequestAutoMerge": `null,"errors":[{"message":"Pull request is in clean status"}]`,
+		"getPullRequestMergeability": mergeabilityResponse("MERGEABLE", "CLEAN"),
+		"mergePullRequest":           `{"mergePullRequest":{"pullRequest":{"merged":true,"mergedAt":"2024-01-01T00:00:00Z","mergeCommit":{"oid":"merge-sha"}}}}`,
+	})
+	ghrc.mergeMode = MergeModeAuto
+
+	sha, err := ghrc.MergeWithMode(context.Background(), zap.NewNop(), "PR_7", 7, PullRequestMergeMethodSquash, &defaultPersona, &ChangeTemplateData{})
+	if err != nil {
+		t.Fatalf("Error merging: %s", err)
+	}
+	if sha != "merge-sha" || fake.counts["mergePullRequest"] != 1 {
+		t.Errorf("Expected a clean PR to be merged directly, got sha %q", sha)
+	}
+	if fake.counts["getPullRequestMergeState"] != 0 {
+		t.Errorf("Expected no wait for a PR that was merged directly")
+	}
+}
+
+func TestMergeWithModeAutoBehind(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"enablePullRequestAutoMerge": `{"enablePullRequestAutoMerge":{"pullRequest":{"id":"PR_7"}}}`,
+		"getPullRequestMergeability": mergeabilityResponse("MERGEABLE", "BEHIND"),
+		"updatePullRequestBranch":    `{"updatePullRequestBranch":{"pullRequest":{"headRefOid":"new789"}}}`,
+	})
+	behind := strings.Replace(mergeState("OPEN", false, "SUCCESS"), `"mergedAt":null,`, `"mergedAt":null,"mergeStateStatus":"BEHIND",`, 1)
+	fake.pages["getPullRequestMergeState"] = []string{behind, mergeState("MERGED", false, "SUCCESS")}
+	ghrc.mergeMode = MergeModeAuto
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	sha, err := ghrc.MergeWithMode(context.Background(), zap.NewNop(), "PR_7", 7, PullRequestMergeMethodSquash, &defaultPersona, &ChangeTemplateData{})
+	if err != nil {
+		t.Fatalf("Error merging: %s", err)
+	}
+	if sha != "merge-sha" {
+		t.Errorf("Expected deploy sha merge-sha, got %s", sha)
+	}
+	if fake.counts["updatePullRequestBranch"] != 1 {
+		t.Errorf("Expected a PR that is behind to be updated, got %d updates", fake.counts["updatePullRequestBranch"])
+	}
+	if fake.counts["enablePullRequestAutoMerge"] != 1 {
+		t.Errorf("Expected auto-merge to be enabled once, got %d", fake.counts["enablePullRequestAutoMerge"])
+	}
+}
+
+func TestMergeWithModeQueueDequeued(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"enqueuePullRequest":    `{"enqueuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","position":1}}}`,
+		"getPullRequestHead":    `{"repository":{"pullRequest":{"id":"PR_7","body":"","headRefName":"dora-the-explorer-7","headRefOid":"abc123","headRef":{"id":"REF_7"}}}}`,
+		"updatePullRequestBody": `{"updatePullRequest":{"pullRequest":{"id":"PR_7"}}}`,
+	})
+	fake.pages["getPullRequestMergeState"] = []string{mergeState("OPEN", true, "SUCCESS"), mergeState("OPEN", false, "SUCCESS")}
+	ghrc.mergeMode = MergeModeQueue
+	ghrc.checkFailurePolicy = CheckFailurePolicyLeave
+	ghrc.history = NewHistory(10, zap.NewNop())
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	_, err := ghrc.MergeWithMode(context.Background(), zap.NewNop(), "PR_7", 7, PullRequestMergeMethodMerge, &defaultPersona, &ChangeTemplateData{})
+	if !errors.Is(err, ErrStatusChecksFailed) {
+		t.Fatalf("Expected ErrStatusChecksFailed, got %v", err)
+	}
+	events := ghrc.history.Recent(10)
+	if len(events) != 2 || events[0].Type != EventChecksFailed || events[1].Type != EventPRLeftOpen {
+		t.Errorf("Expected the check failure policy to be applied, got %v", events)
+	}
+}
+
+func TestWaitForMergeFailedChecks(t *testing.T) {
+	_, ghrc := newFakeGitHub(t, map[string]string{"getPullRequestMergeState": mergeState("OPEN", false, "FAILURE")})
+	ghrc.mergeMode = MergeModeAuto
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	if _, err := ghrc.WaitForMerge(context.Background(), 7); !errors.Is(err, ErrStatusChecksFailed) {
+		t.Errorf("Expected ErrStatusChecksFailed, got %v", err)
+	}
+}
+
+func TestWaitForMergeClosed(t *testing.T) {
+	_, ghrc := newFakeGitHub(t, map[string]string{
+		"enablePullRequestAutoMerge": `{"enablePullRequestAutoMerge":{"pullRequest":{"id":"PR_7"}}}`,
+		"getPullRequestMergeState":   mergeState("CLOSED", false, "SUCCESS"),
+	})
+	ghrc.mergeMode = MergeModeAuto
+	ghrc.history = NewHistory(10, zap.NewNop())
+	ghrc.webhooks = NewWebhookReceiver("s3cret", zap.NewNop())
+	defer keepWaking(ghrc.webhooks, pullRequestKey(7))()
+
+	// The failure policy is not applied to a pull request that is already closed
+	_, err := ghrc.MergeWithMode(context.Background(), zap.NewNop(), "PR_7", 7, PullRequestMergeMethodSquash, &defaultPersona, &ChangeTemplateData{})
+	if !errors.Is(err, ErrStatusChecksFailed) || !errors.Is(err, errPullRequestClosed) {
+		t.Fatalf("Expected a closed PR error, got %v", err)
+	}
+	if events := ghrc.history.Recent(10); len(events) != 1 || events[0].Type != EventPRClosed {
+		t.Errorf("Expected a single pr_closed event, got %v", events)
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	MetricsSourceJournal = "journal" // Events recorded by Dora the Explorer
+	MetricsSourceGitHub  = "github"  // Deployments read back from GitHub
+)
+
+// The four key metrics over a window. Durations are zero and rates negative
+// when there was nothing to measure.
+type DoraMetrics struct {
+	Window              time.Duration
+	Deployments         int
+	DeploymentFrequency float64 // Successful deployments per day
+	LeadTime            time.Duration
+	ChangeFailureRate   float64 // Percentage of deployments that failed
+	TimeToRestore       time.Duration
+}
+
+// Calculates the metrics from the events in the window ending at now.
+// Deployments are the deployed and deploy_failed events, so rollbacks count
+// as deployments. Lead time runs from pushing a change's branch to deploying
+// its merge commit, time to restore from a failed deployment to the next
+// successful one in the same environment. Both are medians.
+func CalculateMetrics(events []Event, window time.Duration, now time.Time) DoraMetrics {
+	m := DoraMetrics{Window: window, ChangeFailureRate: -1}
+	since := now.Add(-window)
+
+	events = slices.Clone(events)
+	slices.SortStableFunc(events, func(a, b Event) int { return a.Time.Compare(b.Time) })
+
+	pushed := map[string]time.Time{}
+	merged := map[string]string{}
+	failedSince := map[string]time.Time{}
+	var succeeded, failed int
+	var leadTimes, restoreTimes []time.Duration
+
+	for _, e := range events {
+		switch e.Type {
+		case EventBranchPushed:
+			if _, ok := pushed[e.ChangeID]; !ok {
+				pushed[e.ChangeID] = e.Time
+			}
+		case EventMerged:
+			merged[e.ChangeID] = e.SHA
+		case EventDeployFailed:
+			if _, ok := failedSince[e.Environment]; !ok {
+				failedSince[e.Environment] = e.Time
+			}
+			if e.Time.After(since) {
+				failed++
+			}
+		case EventDeployed:
+			failedAt, restoring := failedSince[e.Environment]
+			delete(failedSince, e.Environment)
+			if !e.Time.After(since) {
+				continue
+			}
+			succeeded++
+			if restoring {
+				restoreTimes = append(restoreTimes, e.Time.Sub(failedAt))
+			}
+			if start, ok := pushed[e.ChangeID]; ok && e.ChangeID != "" && merged[e.ChangeID] == e.SHA {
+				leadTimes = append(leadTimes, e.Time.Sub(start))
+			}
+		}
+	}
+
+	m.Deployments = succeeded + failed
+	m.DeploymentFrequency = float64(succeeded) / (window.Hours() / 24)
+	if m.Deployments > 0 {
+		m.ChangeFailureRate = float64(failed) / float64(m.Deployments) * 100
+	}
+	m.LeadTime = median(leadTimes)
+	m.TimeToRestore = median(restoreTimes)
+	return m
+}
+
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	durations = slices.Clone(durations)
+	slices.Sort(durations)
+	mid := len(durations) / 2
+	if len(durations)%2 == 0 {
+		return (durations[mid-1] + durations[mid]) / 2
+	}
+	return durations[mid]
+}
+
+// Performance levels, from the DORA bands documented on the DoraTeam
+// constructors
+func ClassifyDeploymentFrequency(perDay float64) string {
+	switch {
+	case perDay >= 1:
+		return "Elite"
+	case perDay >= 1.0/7:
+		return "High"
+	case perDay >= 1.0/30:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+func ClassifyLeadTime(d time.Duration) string {
+	switch {
+	case d == 0:
+		return ""
+	case d < 24*time.Hour:
+		return "Elite"
+	case d < 7*24*time.Hour:
+		return "High"
+	case d < 30*24*time.Hour:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+func ClassifyChangeFailureRate(rate float64) string {
+	switch {
+	case rate < 0:
+		return ""
+	case rate <= 5:
+		return "Elite"
+	case rate <= 10:
+		return "High"
+	case rate <= 15:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+func ClassifyTimeToRestore(d time.Duration) string {
+	switch {
+	case d == 0:
+		return ""
+	case d < time.Hour:
+		return "Elite"
+	case d < 24*time.Hour:
+		return "High"
+	case d < 7*24*time.Hour:
+		return "Medium"
+	default:
+		return "Low"
+	}
+}
+
+// Reads the events of a journal written with DORA_JOURNAL_FILE, keeping only
+// those for the environment unless it is empty
+func ReadJournal(r io.Reader, environment string) ([]Event, error) {
+	var events []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("Error reading journal line %d: %s", line, err)
+		}
+		if environment != "" && e.Environment != "" && e.Environment != environment {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, scanner.Err()
+}
+
+func readJournalFile(path string, environment string) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening journal: %s", err)
+	}
+	defer f.Close()
+	return ReadJournal(f, environment)
+}
+
+// Reads back the deployments created since the given time as deployed and
+// deploy_failed events, dated by the status that completed them. Deployments
+// that have not completed are left out.
+//
+// For lead time every successful deployment also gets branch_pushed and
+// merged events for its commit, keyed by the commit SHA. The change counts as
+// pushed when its pull request was opened, or when the commit was made if
+// that is earlier or it has no pull request.
+func (ghrc *GitHubRepoContext) DeploymentEvents(ctx context.Context, environment string, since time.Time) ([]Event, error) {
+	var environments []string
+	if environment != "" {
+		environments = []string{environment}
+	}
+
+	var events []Event
+	changes := map[string]bool{}
+	var cursor string
+	for {
+		deployments, err := getLatestDeployments(ctx, ghrc.client, ghrc.org, ghrc.name, environments, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting deployments: %s", err)
+		}
+
+		for _, d := range deployments.Repository.Deployments.Nodes {
+			if d.CreatedAt.Before(since) {
+				return events, nil
+			}
+
+			var completed *getLatestDeploymentsRepositoryDeploymentsDeploymentConnectionNodesDeploymentStatusesDeploymentStatusConnectionNodesDeploymentStatus
+			for i, status := range d.Statuses.Nodes {
+				switch status.State {
+				case DeploymentStatusStateSuccess, DeploymentStatusStateFailure, DeploymentStatusStateError:
+					if completed == nil || status.UpdatedAt.After(completed.UpdatedAt) {
+						completed = &d.Statuses.Nodes[i]
+					}
+				}
+			}
+			if completed == nil {
+				continue
+			}
+
+			e := Event{Time: completed.UpdatedAt, Type: EventDeployed, SHA: d.Commit.Oid, Environment: d.Environment}
+			if completed.State != DeploymentStatusStateSuccess {
+				e.Type = EventDeployFailed
+			}
+			if e.Type == EventDeployed && !d.Commit.CommittedDate.IsZero() {
+				e.ChangeID = d.Commit.Oid
+				if !changes[e.ChangeID] {
+					changes[e.ChangeID] = true
+					pushed := d.Commit.CommittedDate
+					for _, pr := range d.Commit.AssociatedPullRequests.Nodes {
+						if pr.CreatedAt.Before(pushed) {
+							pushed = pr.CreatedAt
+						}
+					}
+					events = append(events,
+						Event{Time: pushed, Type: EventBranchPushed, ChangeID: e.ChangeID},
+						Event{Time: d.Commit.CommittedDate, Type: EventMerged, ChangeID: e.ChangeID, SHA: e.SHA})
+				}
+			}
+			events = append(events, e)
+		}
+
+		if !deployments.Repository.Deployments.PageInfo.HasNextPage {
+			return events, nil
+		}
+		cursor = deployments.Repository.Deployments.PageInfo.EndCursor
+	}
+}
+
+// Writes the metrics of each window with the level they classify as, flagging
+// the ones that do not match the configured level
+func WriteMetricsReport(w io.Writer, level string, source string, metrics []DoraMetrics) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s metrics, configured level %s\n", source, level)
+	fmt.Fprintln(tw, "Window\tMetric\tValue\tLevel\t")
+	for _, m := range metrics {
+		rows := []struct {
+			name  string
+			value string
+			level string
+		}{
+			{"Deployment frequency", fmt.Sprintf("%.2f/day", m.DeploymentFrequency), ClassifyDeploymentFrequency(m.DeploymentFrequency)},
+			{"Lead time", m.LeadTime.Round(time.Minute).String(), ClassifyLeadTime(m.LeadTime)},
+			{"Change failure rate", fmt.Sprintf("%.1f%%", m.ChangeFailureRate), ClassifyChangeFailureRate(m.ChangeFailureRate)},
+			{"Time to restore", m.TimeToRestore.Round(time.Minute).String(), ClassifyTimeToRestore(m.TimeToRestore)},
+		}
+		for _, row := range rows {
+			switch {
+			case row.level == "":
+				row.value, row.level = "n/a", "-"
+			case row.level != level:
+				row.level += " (off target)"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", m.Window, row.name, row.value, row.level)
+		}
+	}
+	fmt.Fprintln(tw)
+	return tw.Flush()
+}
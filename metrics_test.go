@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculateMetrics(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	at := func(hoursAgo int) time.Time { return now.Add(-time.Duration(hoursAgo) * time.Hour) }
+
+	events := []Event{
+		// Deployed before the window, only counts towards restoring
+		{Time: at(400), Type: EventDeployFailed, Environment: "production"},
+		// Change 1 deploys 4 hours after its branch was pushed
+		{Time: at(100), Type: EventBranchPushed, ChangeID: "1"},
+		{Time: at(98), Type: EventMerged, ChangeID: "1", SHA: "sha1"},
+		{Time: at(96), Type: EventDeployed, ChangeID: "1", SHA: "sha1", Environment: "production"},
+		// Change 2 fails and is rolled back 2 hours later
+		{Time: at(50), Type: EventBranchPushed, ChangeID: "2"},
+		{Time: at(49), Type: EventMerged, ChangeID: "2", SHA: "sha2"},
+		{Time: at(48), Type: EventDeployFailed, ChangeID: "2", SHA: "sha2", Environment: "production"},
+		{Time: at(46), Type: EventDeployed, ChangeID: "2", SHA: "sha1", Environment: "production"},
+		// Change 3 deploys 8 hours after its branch was pushed
+		{Time: at(10), Type: EventBranchPushed, ChangeID: "3"},
+		{Time: at(9), Type: EventMerged, ChangeID: "3", SHA: "sha3"},
+		{Time: at(2), Type: EventDeployed, ChangeID: "3", SHA: "sha3", Environment: "production"},
+	}
+
+	m := CalculateMetrics(events, 7*24*time.Hour, now)
+	if m.Deployments != 4 {
+		t.Errorf("Expected 4 deployments, got %d", m.Deployments)
+	}
+	if m.DeploymentFrequency != 3.0/7 {
+		t.Errorf("Expected 3 successful deployments in 7 days, got %f", m.DeploymentFrequency)
+	}
+	if m.LeadTime != 6*time.Hour {
+		t.Errorf("Expected a median lead time of 6h, got %s", m.LeadTime)
+	}
+	if m.ChangeFailureRate != 25 {
+		t.Errorf("Expected a change failure rate of 25%%, got %f", m.ChangeFailureRate)
+	}
+	// The failure before the window took 304 hours to restore
+	if m.TimeToRestore != (304+2)*time.Hour/2 {
+		t.Errorf("Expected a median time to restore of 153h, got %s", m.TimeToRestore)
+	}
+
+	empty := CalculateMetrics(nil, 24*time.Hour, now)
+	if empty.ChangeFailureRate >= 0 || ClassifyLeadTime(empty.LeadTime) != "" || ClassifyTimeToRestore(empty.TimeToRestore) != "" {
+		t.Errorf("Expected nothing to be measured without events, got %v", empty)
+	}
+}
+
+func TestClassifyMetrics(t *testing.T) {
+	if level := ClassifyDeploymentFrequency(2); level != "Elite" {
+		t.Errorf("Expected 2 deployments a day to be Elite, got %s", level)
+	}
+	if level := ClassifyDeploymentFrequency(1.0 / 14); level != "Medium" {
+		t.Errorf("Expected a deployment every 2 weeks to be Medium, got %s", level)
+	}
+	if level := ClassifyLeadTime(3 * 24 * time.Hour); level != "High" {
+		t.Errorf("Expected a 3 day lead time to be High, got %s", level)
+	}
+	if level := ClassifyChangeFailureRate(64); level != "Low" {
+		t.Errorf("Expected a 64%% change failure rate to be Low, got %s", level)
+	}
+	if level := ClassifyTimeToRestore(30 * time.Minute); level != "Elite" {
+		t.Errorf("Expected restoring in 30 minutes to be Elite, got %s", level)
+	}
+}
+
+func TestDeploymentEvents(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	ts := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getLatestDeployments": `{"repository":{"deployments":{"pageInfo":{"hasNextPage":true,"endCursor":"next"},"nodes":[
+			{"createdAt":"` + ts(time.Hour) + `","environment":"production","commit":{"oid":"sha3"},"statuses":{"nodes":[
+				{"updatedAt":"` + ts(50*time.Minute) + `","state":"IN_PROGRESS"}]}},
+			{"createdAt":"` + ts(3*time.Hour) + `","environment":"production","commit":{"oid":"sha2"},"statuses":{"nodes":[
+				{"updatedAt":"` + ts(2*time.Hour) + `","state":"INACTIVE"},
+				{"updatedAt":"` + ts(170*time.Minute) + `","state":"FAILURE"}]}},
+			{"createdAt":"` + ts(48*time.Hour) + `","environment":"production","commit":{"oid":"sha1"},"statuses":{"nodes":[
+				{"updatedAt":"` + ts(47*time.Hour) + `","state":"SUCCESS"}]}}
+		]}}}`,
+	})
+
+	events, err := ghrc.DeploymentEvents(context.Background(), "production", now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Error reading deployments: %s", err)
+	}
+	if len(events) != 1 || events[0].Type != EventDeployFailed || events[0].SHA != "sha2" || !events[0].Time.Equal(now.Add(-170*time.Minute)) {
+		t.Errorf("Expected only the failed deployment, got %v", events)
+	}
+	if fake.counts["getLatestDeployments"] != 1 {
+		t.Errorf("Expected paging to stop at the first deployment before the window, got %d pages", fake.counts["getLatestDeployments"])
+	}
+}
+
+func TestDeploymentEventsLeadTime(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	ts := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+
+	_, ghrc := newFakeGitHub(t, map[string]string{
+		"getLatestDeployments": `{"repository":{"deployments":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"createdAt":"` + ts(2*time.Hour) + `","environment":"production","commit":{"oid":"sha2","committedDate":"` + ts(3*time.Hour) + `",
+				"associatedPullRequests":{"nodes":[{"createdAt":"` + ts(5*time.Hour) + `"}]}},"statuses":{"nodes":[
+				{"updatedAt":"` + ts(time.Hour) + `","state":"SUCCESS"}]}},
+			{"createdAt":"` + ts(4*time.Hour) + `","environment":"production","commit":{"oid":"sha1","committedDate":"` + ts(6*time.Hour) + `",
+				"associatedPullRequests":{"nodes":[]}},"statuses":{"nodes":[
+				{"updatedAt":"` + ts(4*time.Hour) + `","state":"SUCCESS"}]}}
+		]}}}`,
+	})
+
+	events, err := ghrc.DeploymentEvents(context.Background(), "production", now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Error reading deployments: %s", err)
+	}
+
+	// sha2 took 4 hours from its pull request, sha1 2 hours from its commit
+	m := CalculateMetrics(events, 24*time.Hour, now)
+	if m.LeadTime != 3*time.Hour {
+		t.Errorf("Expected a lead time of 3h, got %s", m.LeadTime)
+	}
+}
+
+func TestWriteMetricsReport(t *testing.T) {
+	var out bytes.Buffer
+	metrics := []DoraMetrics{{Window: 24 * time.Hour, DeploymentFrequency: 2, LeadTime: 3 * 24 * time.Hour, ChangeFailureRate: -1}}
+	if err := WriteMetricsReport(&out, "Elite", MetricsSourceJournal, metrics); err != nil {
+		t.Fatalf("Error writing report: %s", err)
+	}
+
+	report := out.String()
+	for _, expected := range []string{"journal metrics, configured level Elite", "2.00/day", "High (off target)", "n/a"} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("Expected the report to contain %q, got:\n%s", expected, report)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// A pull request that was opened and is waiting to be abandoned, or to be
+// picked up after sitting idle
+type PendingChange struct {
+	Fate     string // PullRequestFateAbandon or PullRequestFateIdle
+	Due      time.Time
+	Delay    time.Duration // How long the pull request was left waiting
+	PRNumber int
+	PRId     string
+	Persona  *Persona
+	Data     *ChangeTemplateData
+}
+
+// The pull requests waiting for their fate, so the loop can carry on with new
+// changes instead of sleeping until they are due. The sweeper checks it to
+// leave pending pull requests alone. It is only kept in memory, after a
+// restart the pull requests stay open until they are swept.
+type PendingChanges struct {
+	mu      sync.Mutex
+	changes []*PendingChange
+}
+
+func (p *PendingChanges) Add(c *PendingChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = append(p.changes, c)
+}
+
+func (p *PendingChanges) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.changes)
+}
+
+// Whether the pull request is waiting for its fate
+func (p *PendingChanges) Has(prNumber int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, c := range p.changes {
+		if c.PRNumber == prNumber {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns when the earliest pending change is due, false when there is none
+func (p *PendingChanges) NextDue() (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var next time.Time
+	for _, c := range p.changes {
+		if next.IsZero() || c.Due.Before(next) {
+			next = c.Due
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// Removes and returns the earliest change that is due at now, nil when none is
+func (p *PendingChanges) PopDue(now time.Time) *PendingChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	index := -1
+	for i, c := range p.changes {
+		if !c.Due.After(now) && (index == -1 || c.Due.Before(p.changes[index].Due)) {
+			index = i
+		}
+	}
+	if index == -1 {
+		return nil
+	}
+	c := p.changes[index]
+	p.changes = append(p.changes[:index], p.changes[index+1:]...)
+	return c
+}
+
+// Whether the pending pull request is still open. One that was closed
+// meanwhile, by a person or the sweeper, is recorded as closed and dropped.
+func (ghrc *GitHubRepoContext) PendingStillOpen(ctx context.Context, logger *zap.Logger, c *PendingChange) (bool, error) {
+	resp, err := getPullRequestMergeState(ctx, ghrc.client, ghrc.org, ghrc.name, c.PRNumber)
+	if err != nil {
+		return false, fmt.Errorf("Error getting PR %d: %s", c.PRNumber, err)
+	}
+	if state := resp.Repository.PullRequest.State; state != PullRequestStateOpen {
+		logger.Sugar().Infof("PR %d is %s, dropping it", c.PRNumber, strings.ToLower(string(state)))
+		ghrc.history.Record(Event{Type: EventPRClosed, PRNumber: c.PRNumber, Detail: "closed while pending"})
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestPendingChanges(t *testing.T) {
+	now := time.Now()
+	var pending PendingChanges
+	if _, ok := pending.NextDue(); ok {
+		t.Errorf("Expected no pending change to be due")
+	}
+
+	pending.Add(&PendingChange{PRNumber: 1, Due: now.Add(time.Hour)})
+	pending.Add(&PendingChange{PRNumber: 2, Due: now.Add(-time.Minute)})
+	pending.Add(&PendingChange{PRNumber: 3, Due: now.Add(-time.Hour)})
+
+	if due, ok := pending.NextDue(); !ok || !due.Equal(now.Add(-time.Hour)) {
+		t.Errorf("Expected the earliest change to be next, got %s", due)
+	}
+	for _, number := range []int{3, 2} {
+		if c := pending.PopDue(now); c == nil || c.PRNumber != number {
+			t.Errorf("Expected PR %d to be due, got %v", number, c)
+		}
+	}
+	if c := pending.PopDue(now); c != nil {
+		t.Errorf("Expected no more changes to be due, got PR %d", c.PRNumber)
+	}
+	if !pending.Has(1) || pending.Has(2) {
+		t.Errorf("Expected only PR 1 to be pending")
+	}
+	if pending.Len() != 1 {
+		t.Errorf("Expected PR 1 to still be pending, got %d changes", pending.Len())
+	}
+}
+
+func TestPendingStillOpen(t *testing.T) {
+	for _, state := range []string{"OPEN", "CLOSED", "MERGED"} {
+		_, ghrc := newFakeGitHub(t, map[string]string{
+			"getPullRequestMergeState": `{"repository":{"pullRequest":{"state":"` + state + `"}}}`,
+		})
+		ghrc.history = NewHistory(10, zap.NewNop())
+
+		open, err := ghrc.PendingStillOpen(context.Background(), zap.NewNop(), &PendingChange{PRNumber: 1})
+		if err != nil {
+			t.Fatalf("Error checking PR: %s", err)
+		}
+		if open != (state == "OPEN") {
+			t.Errorf("Expected a %s PR to be open %v", state, state == "OPEN")
+		}
+		if events := ghrc.history.Recent(10); !open && (len(events) != 1 || events[0].Type != EventPRClosed) {
+			t.Errorf("Expected a pr_closed event for a %s PR, got %v", state, events)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// The identity used when no persona pool is configured
+var defaultPersona = Persona{
+	Name:   "Bill Murray",
+	Email:  "ghostbuster-bill@hookandladder8.com",
+	Weight: 1,
+}
+
+type HourRange struct {
+	Start int `json:"start"` // Inclusive hour of the day, 0-23
+	End   int `json:"end"`   // Exclusive hour of the day, 1-24
+}
+
+// A simulated team member. Changes are authored by a persona and, when the
+// persona has a token, their pull requests are opened with that token.
+type Persona struct {
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Token       string     `json:"token,omitempty"`
+	Weight      int        `json:"weight"`
+	ActiveHours *HourRange `json:"activeHours,omitempty"`
+	Timezone    string     `json:"timezone,omitempty"`
+
+	location *time.Location
+	login    string // GitHub login behind Token, resolved on first use
+	nodeId   string
+}
+
+type PersonaPool struct {
+	Personas []*Persona
+}
+
+// Loads the persona pool from DORA_PERSONAS_FILE or DORA_PERSONAS, both of
+// which hold a JSON list of personas. Falls back to a single default persona.
+func NewPersonaPoolFromEnv() (*PersonaPool, error) {
+	raw := []byte(os.Getenv("DORA_PERSONAS"))
+	if path := os.Getenv("DORA_PERSONAS_FILE"); path != "" {
+		var err error
+		raw, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading DORA_PERSONAS_FILE: %s", err)
+		}
+	}
+
+	if len(raw) == 0 {
+		p := defaultPersona
+		return &PersonaPool{Personas: []*Persona{&p}}, nil
+	}
+
+	var personas []*Persona
+	if err := json.Unmarshal(raw, &personas); err != nil {
+		return nil, fmt.Errorf("Error parsing personas: %s", err)
+	}
+	return NewPersonaPool(personas)
+}
+
+func NewPersonaPool(personas []*Persona) (*PersonaPool, error) {
+	if len(personas) == 0 {
+		return nil, errors.New("Persona pool is empty")
+	}
+
+	for _, p := range personas {
+		if p.Name == "" || p.Email == "" {
+			return nil, errors.New("Every persona needs a name and an email")
+		}
+		if p.Weight < 0 {
+			return nil, fmt.Errorf("Invalid weight for %s: must not be negative", p.Name)
+		}
+		if p.Weight == 0 {
+			p.Weight = 1
+		}
+		if p.ActiveHours != nil {
+			if p.ActiveHours.Start < 0 || p.ActiveHours.Start > 23 || p.ActiveHours.End < 1 || p.ActiveHours.End > 24 {
+				return nil, fmt.Errorf("Invalid active hours for %s: %d-%d", p.Name, p.ActiveHours.Start, p.ActiveHours.End)
+			}
+		}
+		p.location = time.UTC
+		if p.Timezone != "" {
+			loc, err := time.LoadLocation(p.Timezone)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid timezone for %s: %s", p.Name, err)
+			}
+			p.location = loc
+		}
+	}
+
+	return &PersonaPool{Personas: personas}, nil
+}
+
+// Returns true if the persona works during the hour of the given time. Ranges
+// that wrap past midnight, ie: 22-6, are supported.
+func (p *Persona) IsActive(t time.Time) bool {
+	if p.ActiveHours == nil {
+		return true
+	}
+	loc := p.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	hour := t.In(loc).Hour()
+	if p.ActiveHours.Start < p.ActiveHours.End {
+		return hour >= p.ActiveHours.Start && hour < p.ActiveHours.End
+	}
+	return hour >= p.ActiveHours.Start || hour < p.ActiveHours.End
+}
+
+// Picks a persona weighted by their weight from the personas that are active
+// at the given time. If nobody is active the whole pool is sampled.
+func (pp *PersonaPool) Sample(t time.Time) *Persona {
+	var active []Weighted[*Persona]
+	for _, p := range pp.Personas {
+		if p.IsActive(t) {
+			active = append(active, Weighted[*Persona]{Value: p, Weight: p.Weight})
+		}
+	}
+
+	if len(active) == 0 {
+		for _, p := range pp.Personas {
+			active = append(active, Weighted[*Persona]{Value: p, Weight: p.Weight})
+		}
+	}
+
+	return PickWeighted(active)
+}
+
+// Builds reviewer personas from a comma separated list of tokens. Their names
+// are resolved from GitHub when they are first used.
+func NewReviewersFromTokens(tokens string) []*Persona {
+	var reviewers []*Persona
+	for _, token := range strings.Split(tokens, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			reviewers = append(reviewers, &Persona{Name: "reviewer", Token: token, Weight: 1})
+		}
+	}
+	return reviewers
+}
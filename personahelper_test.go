@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDefaultPersonaPool(t *testing.T) {
+	pp, err := NewPersonaPoolFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading personas: %s", err)
+	}
+
+	p := pp.Sample(time.Now())
+	if p.Name != "Bill Murray" {
+		t.Errorf("Expected default persona to be Bill Murray, got %s", p.Name)
+	}
+}
+
+func TestPersonaActiveHours(t *testing.T) {
+	os.Setenv("DORA_PERSONAS", `[
+		{"name": "Day", "email": "day@example.com", "activeHours": {"start": 9, "end": 17}},
+		{"name": "Night", "email": "night@example.com", "token": "night-token", "activeHours": {"start": 22, "end": 6}}
+	]`)
+	defer os.Unsetenv("DORA_PERSONAS")
+
+	pp, err := NewPersonaPoolFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading personas: %s", err)
+	}
+
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if p := pp.Sample(noon); p.Name != "Day" {
+		t.Errorf("Expected Day persona at noon, got %s", p.Name)
+	}
+
+	midnight := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	if p := pp.Sample(midnight); p.Name != "Night" {
+		t.Errorf("Expected Night persona at midnight, got %s", p.Name)
+	}
+
+	ghrc := &GitHubRepoContext{pat: "test-pat"}
+	if token := ghrc.tokenFor(pp.Personas[0]); token != "test-pat" {
+		t.Errorf("Expected persona without a token to use the PAT, got %s", token)
+	}
+	if token := ghrc.tokenFor(pp.Personas[1]); token != "night-token" {
+		t.Errorf("Expected persona token to be night-token, got %s", token)
+	}
+}
+
+func TestInvalidPersonas(t *testing.T) {
+	if _, err := NewPersonaPool([]*Persona{{Name: "No Email"}}); err == nil {
+		t.Errorf("Expected an error for a persona without an email")
+	}
+	if _, err := NewPersonaPool([]*Persona{{Name: "A", Email: "a@example.com", Timezone: "Not/AZone"}}); err == nil {
+		t.Errorf("Expected an error for an invalid timezone")
+	}
+}
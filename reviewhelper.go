@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	reviewNoteReExpression = `(?m)^# dora-the-explorer: .*$`
+)
+
+// Resolves the GitHub login and node ID behind the persona's token
+func (ghrc *GitHubRepoContext) resolveIdentity(ctx context.Context, persona *Persona) error {
+	if persona.nodeId != "" {
+		return nil
+	}
+	viewer, err := getViewerIdentity(ctx, ghrc.clientFor(persona))
+	if err != nil {
+		return fmt.Errorf("Error resolving identity of %s: %s", persona.Name, err)
+	}
+	persona.login = viewer.Viewer.Login
+	persona.nodeId = viewer.Viewer.Id
+	return nil
+}
+
+// Returns the personas that can review a pull request opened by the author:
+// everyone with their own token except the author, followed by the reviewers
+// from DORA_REVIEWER_TOKENS.
+func (ghrc *GitHubRepoContext) reviewerCandidates(author *Persona) []*Persona {
+	authorToken := ghrc.tokenFor(author)
+
+	var candidates []*Persona
+	for _, p := range ghrc.personas.Personas {
+		if p.Token != "" && p != author && p.Token != authorToken {
+			candidates = append(candidates, p)
+		}
+	}
+	for _, p := range ghrc.reviewers {
+		if p.Token != authorToken {
+			candidates = append(candidates, p)
+		}
+	}
+	return candidates
+}
+
+// Simulates code review of a pull request. Reviews are requested from up to
+// profile.Reviewers reviewers, each of whom picks the review up after a sampled
+// latency. The first reviewer may request changes, after which the author
+// pushes a fix-up commit before the reviewer approves.
+//
+// When no reviewer tokens are configured the review stage is skipped.
+func (ghrc *GitHubRepoContext) SimulateReview(
+	ctx context.Context,
+	logger *zap.Logger,
+	profile ReviewProfile,
+	prNumber int,
+	author *Persona,
+	data *ChangeTemplateData) error {
+
+	candidates := ghrc.reviewerCandidates(author)
+	if len(candidates) == 0 || profile.Reviewers <= 0 {
+		logger.Sugar().Info("No reviewers configured, skipping review")
+		return nil
+	}
+
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > profile.Reviewers {
+		candidates = candidates[:profile.Reviewers]
+	}
+
+	pr, err := getPullRequestHead(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+	if err != nil {
+		return fmt.Errorf("Error getting PR %d: %s", prNumber, err)
+	}
+	prId := pr.Repository.PullRequest.Id
+
+	var userIds []string
+	for _, reviewer := range candidates {
+		if err := ghrc.resolveIdentity(ctx, reviewer); err != nil {
+			return err
+		}
+		userIds = append(userIds, reviewer.nodeId)
+	}
+
+	_, err = requestReviews(ctx, ghrc.clientFor(author), prId, userIds)
+	if err != nil {
+		return fmt.Errorf("Error requesting reviews on PR %d: %s", prNumber, err)
+	}
+	logger.Sugar().Infof("Requested %d review(s) on PR %d", len(userIds), prNumber)
+
+	for i, reviewer := range candidates {
+		pickup := time.Duration(profile.PickupMinutes.Sample()) * time.Minute
+		logger.Sugar().Infof("%s picks up the review of PR %d in %s", reviewer.login, prNumber, pickup)
+		if err := ghrc.sleep(ctx, pickup); err != nil {
+			return err
+		}
+
+		if err := ghrc.sleep(ctx, time.Duration(profile.ReviewMinutes.Sample())*time.Minute); err != nil {
+			return err
+		}
+
+		//nolint:gosec // No security issue, just need a psudo-random outcome
+		if i == 0 && rand.Float64()*100 < profile.ChangesRequestedRate {
+			_, err = addPullRequestReview(ctx,
+				ghrc.clientFor(reviewer),
+				prId,
+				PullRequestReviewEventRequestChanges,
+				"Please address the review feedback before merging.")
+			if err != nil {
+				return fmt.Errorf("Error requesting changes on PR %d: %s", prNumber, err)
+			}
+			logger.Sugar().Infof("%s requested changes on PR %d", reviewer.login, prNumber)
+
+			// The author addresses the feedback
+			if err := ghrc.sleep(ctx, time.Duration(profile.ReviewMinutes.Sample())*time.Minute); err != nil {
+				return err
+			}
+			note := fmt.Sprintf("# dora-the-explorer: addressed review feedback for %s", data.WorkItem)
+			sha, err := ghrc.PushFixupCommit(ctx, logger, prNumber, author, "Address review feedback", func(bb []byte) []byte {
+				return setReviewNote(bb, note)
+			})
+			if err != nil {
+				return err
+			}
+			logger.Sugar().Infof("Pushed fix-up commit %s to PR %d", sha, prNumber)
+
+			// The reviewer looks at the fix-up
+			if err := ghrc.sleep(ctx, time.Duration(profile.ReviewMinutes.Sample())*time.Minute); err != nil {
+				return err
+			}
+		}
+
+		_, err = addPullRequestReview(ctx, ghrc.clientFor(reviewer), prId, PullRequestReviewEventApprove, "LGTM")
+		if err != nil {
+			return fmt.Errorf("Error approving PR %d: %s", prNumber, err)
+		}
+		logger.Sugar().Infof("%s approved PR %d", reviewer.login, prNumber)
+	}
+
+	return nil
+}
+
+// Commits an edit of the target file to the head branch of the pull request
+// through the GraphQL API, as the given persona. Returns the new commit SHA.
+func (ghrc *GitHubRepoContext) PushFixupCommit(
+	ctx context.Context,
+	logger *zap.Logger,
+	prNumber int,
+	persona *Persona,
+	message string,
+	edit func([]byte) []byte) (string, error) {
+
+	pr, err := getPullRequestHead(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+	if err != nil {
+		return "", fmt.Errorf("Error getting PR %d: %s", prNumber, err)
+	}
+	headOid := pr.Repository.PullRequest.HeadRefOid
+
+	file, err := getRepositoryFile(ctx, ghrc.client, ghrc.org, ghrc.name, headOid+":"+hclPath)
+	if err != nil {
+		return "", fmt.Errorf("Error reading %s: %s", hclPath, err)
+	}
+	blob, ok := file.Repository.Object.(*getRepositoryFileRepositoryObjectBlob)
+	if !ok || blob.IsBinary {
+		return "", fmt.Errorf("%s not found on PR %d", hclPath, prNumber)
+	}
+
+	headline, body := splitCommitMessage(message)
+	body = creditPersona(body, persona)
+
+	commit, err := createCommitOnBranch(ctx,
+		ghrc.clientFor(persona),
+		ghrc.org+"/"+ghrc.name,
+		pr.Repository.PullRequest.HeadRefName,
+		headOid,
+		headline,
+		body,
+		hclPath,
+		base64.StdEncoding.EncodeToString(edit([]byte(blob.Text))))
+	if err != nil {
+		logger.Sugar().Errorf("Error pushing fix-up commit to PR %d: %s", prNumber, err)
+		return "", err
+	}
+
+	return commit.CreateCommitOnBranch.Commit.Oid, nil
+}
+
+// Replaces the dora-the-explorer note line in the file, or appends one
+func setReviewNote(bb []byte, note string) []byte {
+	re := regexp.MustCompile(reviewNoteReExpression)
+	if re.Match(bb) {
+		return re.ReplaceAllLiteral(bb, []byte(note))
+	}
+	if len(bb) > 0 && bb[len(bb)-1] != '\n' {
+		bb = append(bb, '\n')
+	}
+	return append(bb, []byte(note+"\n")...)
+}
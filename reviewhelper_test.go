@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestSimulateReviewRequestsChanges(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getPullRequestHead":   `{"repository":{"pullRequest":{"id":"PR_1","headRefName":"dora-the-explorer-1","headRefOid":"abc123"}}}`,
+		"getViewerIdentity":    `{"viewer":{"id":"U_2","login":"reviewer-bot"}}`,
+		"requestReviews":       `{"requestReviews":{"pullRequest":{"id":"PR_1"}}}`,
+		"addPullRequestReview": `{"addPullRequestReview":{"pullRequestReview":{"id":"PRR_1","state":"APPROVED","submittedAt":"2024-01-01T00:00:00Z"}}}`,
+		"getRepositoryFile":    `{"repository":{"object":{"__typename":"Blob","text":"source = \"x\"\n","isBinary":false}}}`,
+		"createCommitOnBranch": `{"createCommitOnBranch":{"commit":{"oid":"def456","url":"https://example.com"}}}`,
+	})
+	ghrc.personas, _ = NewPersonaPoolFromEnv()
+	ghrc.reviewers = NewReviewersFromTokens("reviewer-token")
+
+	profile := ReviewProfile{ChangesRequestedRate: 100, Reviewers: 1}
+	data := &ChangeTemplateData{WorkItem: "DORA-1234"}
+	err := ghrc.SimulateReview(context.Background(), zap.NewNop(), profile, 1, ghrc.personas.Personas[0], data)
+	if err != nil {
+		t.Fatalf("Error simulating review: %s", err)
+	}
+
+	if ids := fake.calls["requestReviews"]["userIds"].([]interface{}); len(ids) != 1 || ids[0] != "U_2" {
+		t.Errorf("Expected review requested from U_2, got %v", ids)
+	}
+	if fake.counts["addPullRequestReview"] != 2 {
+		t.Errorf("Expected changes requested then approval, got %d reviews", fake.counts["addPullRequestReview"])
+	}
+	if fake.calls["addPullRequestReview"]["event"] != "APPROVE" {
+		t.Errorf("Expected the last review to approve, got %v", fake.calls["addPullRequestReview"]["event"])
+	}
+
+	contents, _ := base64.StdEncoding.DecodeString(fake.calls["createCommitOnBranch"]["contents"].(string))
+	if !strings.Contains(string(contents), "# dora-the-explorer: addressed review feedback for DORA-1234") {
+		t.Errorf("Expected fix-up commit to add a review note, got %s", contents)
+	}
+}
+
+func TestSimulateReviewWithoutReviewers(t *testing.T) {
+	fake, ghrc := newFakeGitHub(t, map[string]string{})
+	ghrc.personas, _ = NewPersonaPoolFromEnv()
+
+	profile := ReviewProfile{Reviewers: 1}
+	if err := ghrc.SimulateReview(context.Background(), zap.NewNop(), profile, 1, ghrc.personas.Personas[0], &ChangeTemplateData{}); err != nil {
+		t.Fatalf("Error simulating review: %s", err)
+	}
+	if len(fake.counts) != 0 {
+		t.Errorf("Expected no API calls without reviewers, got %v", fake.counts)
+	}
+}
+
+func TestSetReviewNote(t *testing.T) {
+	bb := setReviewNote([]byte("a = 1"), "# dora-the-explorer: one")
+	bb = setReviewNote(bb, "# dora-the-explorer: two")
+	if string(bb) != "a = 1\n# dora-the-explorer: two\n" {
+		t.Errorf("Unexpected contents: %q", bb)
+	}
+}
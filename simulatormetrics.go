@@ -0,0 +1,170 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Phases of a change cycle that errors are counted by
+const (
+	PhaseSchedule = "schedule"
+	PhaseSweep    = "sweep"
+	PhaseGenerate = "generate"
+	PhaseAbandon  = "abandon"
+	PhaseReview   = "review"
+	PhaseMerge    = "merge"
+	PhaseCleanup  = "cleanup"
+	PhaseDeploy   = "deploy"
+	PhaseRollback = "rollback"
+)
+
+// Prometheus metrics about the simulator itself, as opposed to the DORA
+// metrics of the simulated team. Every series is labelled with the org, repo
+// and level being simulated. Methods on a nil *SimulatorMetrics do nothing.
+type SimulatorMetrics struct {
+	registry           *prometheus.Registry
+	pullRequests       prometheus.Counter
+	merges             prometheus.Counter
+	deployments        prometheus.Counter
+	deploymentFailures prometheus.Counter
+	incidents          prometheus.Counter
+	errors             *prometheus.CounterVec
+	statusCheckWait    prometheus.Histogram
+	deployWait         prometheus.Histogram
+	nextDeploy         prometheus.Gauge
+
+	cadence    DeploymentFilter // Deployments that count towards the cadence
+	mu         sync.Mutex
+	lastDeploy time.Time
+}
+
+// Wait durations range from seconds to the 10 minute timeouts, and to hours
+// for deployment pipelines with soak times
+var waitBuckets = []float64{10, 30, 60, 120, 300, 600, 1800, 3600, 7200}
+
+func NewSimulatorMetrics(org string, repo string, level string, cadence DeploymentFilter) *SimulatorMetrics {
+	m := &SimulatorMetrics{registry: prometheus.NewRegistry(), cadence: cadence}
+	m.registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+
+	registerer := prometheus.WrapRegistererWith(prometheus.Labels{"org": org, "repo": repo, "level": level}, m.registry)
+	counter := func(name string, help string) prometheus.Counter {
+		c := prometheus.NewCounter(prometheus.CounterOpts{Name: "dora_explorer_" + name, Help: help})
+		registerer.MustRegister(c)
+		return c
+	}
+	m.pullRequests = counter("pull_requests_total", "Pull requests opened.")
+	m.merges = counter("merges_total", "Pull requests merged.")
+	m.deployments = counter("deployments_total", "Deployments completed, successful or not.")
+	m.deploymentFailures = counter("deployment_failures_total", "Deployments that failed.")
+	m.incidents = counter("incidents_total", "Incidents opened by failed deployments.")
+
+	m.errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dora_explorer_errors_total",
+		Help: "Errors by the phase of the change cycle they happened in.",
+	}, []string{"phase"})
+	m.statusCheckWait = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "dora_explorer_status_check_wait_seconds",
+		Help:    "Time spent waiting for the status checks of a pull request.",
+		Buckets: waitBuckets,
+	})
+	m.deployWait = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "dora_explorer_deploy_wait_seconds",
+		Help:    "Time spent deploying a merged change, or waiting for its deployment.",
+		Buckets: waitBuckets,
+	})
+	m.nextDeploy = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "dora_explorer_next_deploy_timestamp_seconds",
+		Help: "When the next change is scheduled, as a Unix timestamp.",
+	})
+	sinceLastDeploy := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "dora_explorer_seconds_since_last_deploy",
+		Help: "Seconds since the last deployment counting towards the cadence, NaN until one is known.",
+	}, m.secondsSinceLastDeploy)
+	registerer.MustRegister(m.errors, m.statusCheckWait, m.deployWait, m.nextDeploy, sinceLastDeploy)
+
+	return m
+}
+
+// Serves the metrics in the Prometheus or OpenMetrics text format
+func (m *SimulatorMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
+
+// Counts the pull requests, merges, deployments and incidents recorded in
+// the history
+func (m *SimulatorMetrics) Observe(e Event) {
+	if m == nil {
+		return
+	}
+	if m.cadence.matchesEvent(e) {
+		m.SetLastDeploy(e.Time)
+	}
+	switch e.Type {
+	case EventPROpened:
+		m.pullRequests.Inc()
+	case EventMerged:
+		m.merges.Inc()
+	case EventDeployed:
+		m.deployments.Inc()
+	case EventDeployFailed:
+		m.deployments.Inc()
+		m.deploymentFailures.Inc()
+	case EventIncidentOpened:
+		m.incidents.Inc()
+	}
+}
+
+func (m *SimulatorMetrics) CountError(phase string) {
+	if m == nil {
+		return
+	}
+	m.errors.WithLabelValues(phase).Inc()
+}
+
+func (m *SimulatorMetrics) ObserveStatusCheckWait(d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.statusCheckWait.Observe(d.Seconds())
+}
+
+func (m *SimulatorMetrics) ObserveDeployWait(d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.deployWait.Observe(d.Seconds())
+}
+
+func (m *SimulatorMetrics) SetNextDeploy(at time.Time) {
+	if m == nil {
+		return
+	}
+	m.nextDeploy.Set(float64(at.Unix()))
+}
+
+// Sets the time of the last deployment unless a later one is known
+func (m *SimulatorMetrics) SetLastDeploy(at time.Time) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if at.After(m.lastDeploy) {
+		m.lastDeploy = at
+	}
+}
+
+func (m *SimulatorMetrics) secondsSinceLastDeploy() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastDeploy.IsZero() {
+		return math.NaN()
+	}
+	return time.Since(m.lastDeploy).Seconds()
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func scrape(t *testing.T, m *SimulatorMetrics) string {
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestSimulatorMetrics(t *testing.T) {
+	m := NewSimulatorMetrics("test-org", "test-repo", "elite", DeploymentFilter{})
+	history := NewHistory(10, zap.NewNop())
+	history.AddObserver(m.Observe)
+
+	if body := scrape(t, m); !strings.Contains(body, `dora_explorer_seconds_since_last_deploy{level="elite",org="test-org",repo="test-repo"} NaN`) {
+		t.Errorf("Expected no last deploy before one is known, got\n%s", body)
+	}
+
+	for _, eventType := range []string{EventPROpened, EventMerged, EventDeployStarted, EventDeployFailed, EventIncidentOpened, EventDeployed} {
+		history.Record(Event{Type: eventType})
+	}
+	m.CountError(PhaseReview)
+	m.CountError(PhaseReview)
+	m.ObserveStatusCheckWait(45 * time.Second)
+	m.ObserveDeployWait(3 * time.Minute)
+	m.SetNextDeploy(time.Unix(1700000000, 0))
+
+	body := scrape(t, m)
+	for _, line := range []string{
+		`dora_explorer_pull_requests_total{level="elite",org="test-org",repo="test-repo"} 1`,
+		`dora_explorer_merges_total{level="elite",org="test-org",repo="test-repo"} 1`,
+		`dora_explorer_deployments_total{level="elite",org="test-org",repo="test-repo"} 2`,
+		`dora_explorer_deployment_failures_total{level="elite",org="test-org",repo="test-repo"} 1`,
+		`dora_explorer_incidents_total{level="elite",org="test-org",repo="test-repo"} 1`,
+		`dora_explorer_errors_total{level="elite",org="test-org",phase="review",repo="test-repo"} 2`,
+		`dora_explorer_status_check_wait_seconds_bucket{level="elite",org="test-org",repo="test-repo",le="60"} 1`,
+		`dora_explorer_deploy_wait_seconds_sum{level="elite",org="test-org",repo="test-repo"} 180`,
+		`dora_explorer_next_deploy_timestamp_seconds{level="elite",org="test-org",repo="test-repo"} 1.7e+09`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected %s in\n%s", line, body)
+		}
+	}
+	if strings.Contains(body, "dora_explorer_seconds_since_last_deploy{level=\"elite\",org=\"test-org\",repo=\"test-repo\"} NaN") {
+		t.Errorf("Expected the deployed event to set the last deploy")
+	}
+}
+
+func TestSimulatorMetricsCadence(t *testing.T) {
+	m := NewSimulatorMetrics("test-org", "test-repo", "elite", DeploymentFilter{
+		Environment: "production",
+		States:      []DeploymentStatusState{DeploymentStatusStateSuccess},
+	})
+
+	m.Observe(Event{Type: EventDeployed, Time: time.Now(), Environment: "dev"})
+	m.Observe(Event{Type: EventDeployFailed, Time: time.Now(), Environment: "production"})
+	if !m.lastDeploy.IsZero() {
+		t.Errorf("Expected deployments outside the cadence to be ignored, got %s", m.lastDeploy)
+	}
+
+	at := time.Now()
+	m.Observe(Event{Type: EventDeployed, Time: at, Environment: "production"})
+	if !m.lastDeploy.Equal(at) {
+		t.Errorf("Expected the last deploy to be %s, got %s", at, m.lastDeploy)
+	}
+}
+
+func TestNilSimulatorMetrics(t *testing.T) {
+	var m *SimulatorMetrics
+	m.Observe(Event{Type: EventDeployed})
+	m.CountError(PhaseDeploy)
+	m.ObserveDeployWait(time.Minute)
+	m.SetLastDeploy(time.Now())
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Phase of the change cycle while the loop waits for the next change
+const PhaseIdle = "idle"
+
+// How long a readiness check result is reused, so probes do not spend the
+// GraphQL rate limit
+const readyCacheTTL = 30 * time.Second
+
+// Keeps track of what the change cycle is doing for the health, readiness
+// and status endpoints. The loop beats before every step and promises how long
+// it may stay silent, and is considered stuck once it misses that by more than
+// the grace period. Methods on a nil *StatusTracker do nothing.
+type StatusTracker struct {
+	level      string
+	repository string
+	grace      time.Duration
+	cadence    DeploymentFilter // Deployments shown as the last deployment
+	redactor   *Redactor
+	ready      func(context.Context) error
+
+	mu         sync.Mutex
+	heartbeat  time.Time
+	deadline   time.Time
+	phase      string
+	nextDeploy time.Time
+	lastDeploy *StatusDeployment
+	errors     []StatusError
+	readyAt    time.Time
+	readyErr   error
+}
+
+type StatusDeployment struct {
+	Time        time.Time `json:"time"`
+	SHA         string    `json:"sha,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+}
+
+type StatusError struct {
+	Time  time.Time `json:"time"`
+	Phase string    `json:"phase"`
+	Error string    `json:"error"`
+}
+
+// The body of /status
+type Status struct {
+	Level          string            `json:"level"`
+	Repository     string            `json:"repository"`
+	Phase          string            `json:"phase"`
+	Heartbeat      time.Time         `json:"heartbeat"`
+	LastDeployment *StatusDeployment `json:"last_deployment"`
+	NextDeployment *time.Time        `json:"next_deployment"`
+	RecentErrors   []StatusError     `json:"recent_errors"`
+}
+
+// The number of errors kept for /status
+const maxStatusErrors = 10
+
+// Creates a tracker for the repository. ready checks that the target
+// repository can be reached, errors are redacted before they are shown.
+func NewStatusTracker(level string, repository string, grace time.Duration, cadence DeploymentFilter, redactor *Redactor, ready func(context.Context) error) *StatusTracker {
+	now := time.Now()
+	return &StatusTracker{
+		level:      level,
+		repository: repository,
+		grace:      grace,
+		cadence:    cadence,
+		redactor:   redactor,
+		ready:      ready,
+		heartbeat:  now,
+		deadline:   now,
+		phase:      PhaseIdle,
+		errors:     []StatusError{},
+	}
+}
+
+// Records that the loop is alive and will beat again shortly
+func (s *StatusTracker) Heartbeat() {
+	s.Expect(0)
+}
+
+// Records that the loop is alive and may be silent for d, for example while
+// it sleeps until the next change
+func (s *StatusTracker) Expect(d time.Duration) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heartbeat = time.Now()
+	s.deadline = s.heartbeat.Add(d)
+}
+
+// Records the phase the change cycle entered, which is also a heartbeat
+func (s *StatusTracker) SetPhase(phase string) {
+	if s == nil {
+		return
+	}
+	s.Heartbeat()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.phase = phase
+}
+
+func (s *StatusTracker) SetNextDeploy(at time.Time) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextDeploy = at
+}
+
+// Sets the last deployment unless a later one is known
+func (s *StatusTracker) SetLastDeploy(deployment StatusDeployment) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastDeploy == nil || deployment.Time.After(s.lastDeploy.Time) {
+		s.lastDeploy = &deployment
+	}
+}
+
+// Tracks the deployments recorded in the history that count towards the
+// cadence
+func (s *StatusTracker) Observe(e Event) {
+	if s == nil {
+		return
+	}
+	if s.cadence.matchesEvent(e) {
+		s.SetLastDeploy(StatusDeployment{Time: e.Time, SHA: e.SHA, Environment: e.Environment})
+	}
+}
+
+func (s *StatusTracker) RecordError(phase string, err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = append(s.errors, StatusError{Time: time.Now(), Phase: phase, Error: s.redactor.Redact(err.Error())})
+	if len(s.errors) > maxStatusErrors {
+		s.errors = s.errors[len(s.errors)-maxStatusErrors:]
+	}
+}
+
+func (s *StatusTracker) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := Status{
+		Level:          s.level,
+		Repository:     s.repository,
+		Phase:          s.phase,
+		Heartbeat:      s.heartbeat,
+		LastDeployment: s.lastDeploy,
+		RecentErrors:   append([]StatusError{}, s.errors...),
+	}
+	if !s.nextDeploy.IsZero() {
+		next := s.nextDeploy
+		status.NextDeployment = &next
+	}
+	return status
+}
+
+// Whether the loop beat within its deadline and the grace period
+func (s *StatusTracker) alive(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !now.After(s.deadline.Add(s.grace))
+}
+
+// Runs the readiness check, reusing a recent result
+func (s *StatusTracker) checkReady(ctx context.Context) error {
+	s.mu.Lock()
+	if time.Since(s.readyAt) < readyCacheTTL {
+		defer s.mu.Unlock()
+		return s.readyErr
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err := s.ready(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readyAt, s.readyErr = time.Now(), err
+	return err
+}
+
+// Serves /healthz, /readyz and /status
+func (s *StatusTracker) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if !s.alive(time.Now()) {
+			http.Error(w, "change cycle heartbeat is stale", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n")) //nolint:errcheck // The probe sees the failed write
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := s.checkReady(r.Context()); err != nil {
+			http.Error(w, s.redactor.Redact(err.Error()), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n")) //nolint:errcheck // The probe sees the failed write
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.Status()) //nolint:errcheck // The client sees the failed write
+	})
+	return mux
+}
+
+// Counts the error in the metrics and shows it on /status
+func (ghrc *GitHubRepoContext) recordError(phase string, err error) {
+	ghrc.metrics.CountError(phase)
+	ghrc.status.RecordError(phase, err)
+}
+
+// Sleeps for the given duration unless the context is cancelled first, letting
+// the health check know the loop will be silent meanwhile
+func (ghrc *GitHubRepoContext) sleep(ctx context.Context, d time.Duration) error {
+	ghrc.status.Expect(d)
+	return sleepContext(ctx, d)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func get(handler http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestStatusTrackerHealth(t *testing.T) {
+	s := NewStatusTracker("elite", "test-org/test-repo", 0, DeploymentFilter{}, NewRedactor(), nil)
+	handler := s.Handler()
+
+	s.Expect(time.Hour)
+	if rec := get(handler, "/healthz"); rec.Code != http.StatusOK {
+		t.Errorf("Expected a loop sleeping until its deadline to be healthy, got %d", rec.Code)
+	}
+
+	s.Expect(-time.Minute)
+	if rec := get(handler, "/healthz"); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected a loop past its deadline to be unhealthy, got %d", rec.Code)
+	}
+}
+
+func TestStatusTrackerReadiness(t *testing.T) {
+	checks := 0
+	var readyErr error
+	ready := func(ctx context.Context) error {
+		checks++
+		return readyErr
+	}
+	s := NewStatusTracker("elite", "test-org/test-repo", 0, DeploymentFilter{}, NewRedactor(), ready)
+	handler := s.Handler()
+
+	readyErr = errors.New("Error reading test-org/test-repo: 401 Unauthorized")
+	if rec := get(handler, "/readyz"); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected an unreachable repository not to be ready, got %d", rec.Code)
+	}
+
+	readyErr = nil
+	if rec := get(handler, "/readyz"); rec.Code != http.StatusServiceUnavailable || checks != 1 {
+		t.Errorf("Expected the cached result to be reused, got %d after %d checks", rec.Code, checks)
+	}
+
+	s.readyAt = time.Time{}
+	if rec := get(handler, "/readyz"); rec.Code != http.StatusOK || checks != 2 {
+		t.Errorf("Expected a reachable repository to be ready, got %d after %d checks", rec.Code, checks)
+	}
+}
+
+func TestStatusTrackerStatus(t *testing.T) {
+	redactor := NewRedactor()
+	redactor.AddSecrets("s3cret-pat")
+	s := NewStatusTracker("elite", "test-org/test-repo", 0, DeploymentFilter{Environment: "production"}, redactor, nil)
+
+	next := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	s.SetNextDeploy(next)
+	s.SetPhase(PhaseMerge)
+	s.Observe(Event{Type: EventDeployed, Time: next.Add(-time.Hour), SHA: "abc123", Environment: "production"})
+	s.Observe(Event{Type: EventDeployed, Time: next.Add(-time.Minute), SHA: "fed987", Environment: "dev"})
+	s.SetLastDeploy(StatusDeployment{Time: next.Add(-2 * time.Hour), SHA: "def456"})
+	for i := 0; i < maxStatusErrors+2; i++ {
+		s.RecordError(PhaseSweep, fmt.Errorf("Error %d using s3cret-pat", i))
+	}
+
+	var status Status
+	if err := json.NewDecoder(get(s.Handler(), "/status").Body).Decode(&status); err != nil {
+		t.Fatalf("Error decoding status: %s", err)
+	}
+	if status.Level != "elite" || status.Repository != "test-org/test-repo" || status.Phase != PhaseMerge {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+	if status.NextDeployment == nil || !status.NextDeployment.Equal(next) {
+		t.Errorf("Expected the next deployment at %s, got %v", next, status.NextDeployment)
+	}
+	if status.LastDeployment == nil || status.LastDeployment.SHA != "abc123" || status.LastDeployment.Environment != "production" {
+		t.Errorf("Expected the latest deployment to be kept, got %+v", status.LastDeployment)
+	}
+	if len(status.RecentErrors) != maxStatusErrors {
+		t.Fatalf("Expected the %d most recent errors, got %d", maxStatusErrors, len(status.RecentErrors))
+	}
+	if last := status.RecentErrors[maxStatusErrors-1]; last.Error != "Error 11 using [REDACTED]" || last.Phase != PhaseSweep {
+		t.Errorf("Expected the last error to be redacted, got %+v", last)
+	}
+}
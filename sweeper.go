@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type SweepOptions struct {
+	MaxAge time.Duration // Only branches and pull requests older than this are swept
+	DryRun bool          // List what would be swept without changing anything
+
+	// Pull requests it returns true for are kept, as are their branches. The
+	// simulation keeps the pull requests it still has plans for.
+	Keep func(prNumber int) bool
+}
+
+// What a sweep closed and deleted, or would have in a dry run
+type SweepResult struct {
+	ClosedPullRequests []int
+	DeletedBranches    []string
+}
+
+// Deletes the head branch of a merged pull request
+func (ghrc *GitHubRepoContext) DeleteHeadBranch(ctx context.Context, logger *zap.Logger, prNumber int) error {
+	pr, err := getPullRequestHead(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+	if err != nil {
+		return fmt.Errorf("Error getting PR %d: %s", prNumber, err)
+	}
+
+	refId := pr.Repository.PullRequest.HeadRef.Id
+	if refId == "" {
+		logger.Sugar().Infof("Head branch of PR %d is already deleted", prNumber)
+		return nil
+	}
+
+	if _, err := deleteRef(ctx, ghrc.client, refId); err != nil {
+		return fmt.Errorf("Error deleting branch %s: %s", pr.Repository.PullRequest.HeadRefName, err)
+	}
+	logger.Sugar().Infof("Deleted branch %s", pr.Repository.PullRequest.HeadRefName)
+	return nil
+}
+
+// Closes a pull request unmerged and deletes its head branch
+func (ghrc *GitHubRepoContext) ClosePullRequest(ctx context.Context, logger *zap.Logger, prNumber int) error {
+	pr, err := getPullRequestHead(ctx, ghrc.client, ghrc.org, ghrc.name, prNumber)
+	if err != nil {
+		return fmt.Errorf("Error getting PR %d: %s", prNumber, err)
+	}
+	if _, err := closePullRequest(ctx, ghrc.client, pr.Repository.PullRequest.Id); err != nil {
+		return fmt.Errorf("Error closing PR %d: %s", prNumber, err)
+	}
+	logger.Sugar().Infof("Closed PR %d", prNumber)
+
+	return ghrc.DeleteHeadBranch(ctx, logger, prNumber)
+}
+
+// Whether the pull request was left open for a human, by the leave check
+// failure policy or by hand
+func leftOpen(body string, labels []string) bool {
+	if strings.Contains(body, leftOpenMarker) {
+		return true
+	}
+	for _, label := range labels {
+		if label == leftOpenLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// Closes open generated pull requests and deletes generated branches that are
+// older than opts.MaxAge. Branches are aged by the date of their last commit.
+// Pull requests left open for a human, and their branches, are kept.
+func (ghrc *GitHubRepoContext) Sweep(ctx context.Context, logger *zap.Logger, opts SweepOptions) (*SweepResult, error) {
+	result := &SweepResult{}
+	cutoff := time.Now().Add(-opts.MaxAge)
+	prefix := ghrc.templates.BranchPrefix
+	kept := map[string]bool{}
+
+	var cursor string
+	for {
+		prs, err := getOpenPullRequests(ctx, ghrc.client, ghrc.org, ghrc.name, cursor)
+		if err != nil {
+			return result, fmt.Errorf("Error listing open pull requests: %s", err)
+		}
+
+		for _, pr := range prs.Repository.PullRequests.Nodes {
+			if !strings.HasPrefix(pr.HeadRefName, prefix) || pr.CreatedAt.After(cutoff) {
+				continue
+			}
+			var labels []string
+			for _, label := range pr.Labels.Nodes {
+				labels = append(labels, label.Name)
+			}
+			if leftOpen(pr.Body, labels) {
+				logger.Sugar().Debugf("Keeping PR %d, it was left open", pr.Number)
+				kept[pr.HeadRefName] = true
+				continue
+			}
+			if opts.Keep != nil && opts.Keep(pr.Number) {
+				logger.Sugar().Debugf("Keeping PR %d, it is pending", pr.Number)
+				kept[pr.HeadRefName] = true
+				continue
+			}
+
+			if opts.DryRun {
+				logger.Sugar().Infof("Would close PR %d from %s, opened %s", pr.Number, pr.HeadRefName, pr.CreatedAt)
+			} else {
+				if _, err := closePullRequest(ctx, ghrc.client, pr.Id); err != nil {
+					return result, fmt.Errorf("Error closing PR %d: %s", pr.Number, err)
+				}
+				logger.Sugar().Infof("Closed PR %d from %s", pr.Number, pr.HeadRefName)
+			}
+			result.ClosedPullRequests = append(result.ClosedPullRequests, pr.Number)
+		}
+
+		if !prs.Repository.PullRequests.PageInfo.HasNextPage {
+			break
+		}
+		cursor = prs.Repository.PullRequests.PageInfo.EndCursor
+	}
+
+	cursor = ""
+	for {
+		branches, err := getGeneratedBranches(ctx, ghrc.client, ghrc.org, ghrc.name, prefix, cursor)
+		if err != nil {
+			return result, fmt.Errorf("Error listing branches: %s", err)
+		}
+
+		for _, ref := range branches.Repository.Refs.Nodes {
+			// The query is a fuzzy match, make sure it is a prefix
+			if !strings.HasPrefix(ref.Name, prefix) {
+				continue
+			}
+			commit, ok := ref.Target.(*getGeneratedBranchesRepositoryRefsRefConnectionNodesRefTargetCommit)
+			if !ok || commit.CommittedDate.After(cutoff) || kept[ref.Name] {
+				continue
+			}
+
+			if opts.DryRun {
+				logger.Sugar().Infof("Would delete branch %s, last commit %s", ref.Name, commit.CommittedDate)
+			} else {
+				if _, err := deleteRef(ctx, ghrc.client, ref.Id); err != nil {
+					return result, fmt.Errorf("Error deleting branch %s: %s", ref.Name, err)
+				}
+				logger.Sugar().Infof("Deleted branch %s", ref.Name)
+			}
+			result.DeletedBranches = append(result.DeletedBranches, ref.Name)
+		}
+
+		if !branches.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		cursor = branches.Repository.Refs.PageInfo.EndCursor
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestSweep(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour).Format(time.RFC3339)
+	recent := time.Now().Format(time.RFC3339)
+
+	responses := map[string]string{
+		"getOpenPullRequests": `{"repository":{"pullRequests":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"id":"PR_1","number":1,"createdAt":"` + old + `","headRefName":"dora-the-explorer-1"},
+			{"id":"PR_2","number":2,"createdAt":"` + recent + `","headRefName":"dora-the-explorer-2"},
+			{"id":"PR_3","number":3,"createdAt":"` + old + `","headRefName":"feature/human-work"},
+			{"id":"PR_4","number":4,"createdAt":"` + old + `","headRefName":"dora-the-explorer-4","body":"Bump\n\n` + leftOpenMarker + `"},
+			{"id":"PR_5","number":5,"createdAt":"` + old + `","headRefName":"dora-the-explorer-5","labels":{"nodes":[{"name":"` + leftOpenLabel + `"}]}}
+		]}}}`,
+		"getGeneratedBranches": `{"repository":{"refs":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"id":"REF_1","name":"dora-the-explorer-1","target":{"__typename":"Commit","committedDate":"` + old + `"}},
+			{"id":"REF_2","name":"dora-the-explorer-2","target":{"__typename":"Commit","committedDate":"` + recent + `"}},
+			{"id":"REF_3","name":"not-dora-the-explorer-3","target":{"__typename":"Commit","committedDate":"` + old + `"}},
+			{"id":"REF_4","name":"dora-the-explorer-4","target":{"__typename":"Commit","committedDate":"` + old + `"}},
+			{"id":"REF_5","name":"dora-the-explorer-5","target":{"__typename":"Commit","committedDate":"` + old + `"}}
+		]}}}`,
+		"closePullRequest": `{"closePullRequest":{"pullRequest":{"id":"PR_1","state":"CLOSED"}}}`,
+		"deleteRef":        `{"deleteRef":{"clientMutationId":null}}`,
+	}
+
+	fake, ghrc := newFakeGitHub(t, responses)
+	result, err := ghrc.Sweep(context.Background(), zap.NewNop(), SweepOptions{MaxAge: 7 * 24 * time.Hour, DryRun: true})
+	if err != nil {
+		t.Fatalf("Error sweeping: %s", err)
+	}
+	if len(result.ClosedPullRequests) != 1 || result.ClosedPullRequests[0] != 1 {
+		t.Errorf("Expected only PR 1 to be swept, got %v", result.ClosedPullRequests)
+	}
+	if len(result.DeletedBranches) != 1 || result.DeletedBranches[0] != "dora-the-explorer-1" {
+		t.Errorf("Expected only dora-the-explorer-1 to be swept, got %v", result.DeletedBranches)
+	}
+	if fake.counts["closePullRequest"] != 0 || fake.counts["deleteRef"] != 0 {
+		t.Errorf("Expected a dry run not to change anything, got %v", fake.counts)
+	}
+
+	fake, ghrc = newFakeGitHub(t, responses)
+	if _, err := ghrc.Sweep(context.Background(), zap.NewNop(), SweepOptions{MaxAge: 7 * 24 * time.Hour}); err != nil {
+		t.Fatalf("Error sweeping: %s", err)
+	}
+	if fake.calls["closePullRequest"]["pullRequestId"] != "PR_1" {
+		t.Errorf("Expected PR_1 to be closed, got %v", fake.calls["closePullRequest"])
+	}
+	if fake.calls["deleteRef"]["refId"] != "REF_1" {
+		t.Errorf("Expected REF_1 to be deleted, got %v", fake.calls["deleteRef"])
+	}
+}
+
+func TestSweepKeepsPendingPullRequests(t *testing.T) {
+	old := time.Now().Add(-30 * 24 * time.Hour).Format(time.RFC3339)
+	fake, ghrc := newFakeGitHub(t, map[string]string{
+		"getOpenPullRequests": `{"repository":{"pullRequests":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"id":"PR_1","number":1,"createdAt":"` + old + `","headRefName":"dora-the-explorer-1"}
+		]}}}`,
+		"getGeneratedBranches": `{"repository":{"refs":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"id":"REF_1","name":"dora-the-explorer-1","target":{"__typename":"Commit","committedDate":"` + old + `"}}
+		]}}}`,
+	})
+
+	pending := &PendingChanges{}
+	pending.Add(&PendingChange{PRNumber: 1, Due: time.Now().Add(time.Hour)})
+	result, err := ghrc.Sweep(context.Background(), zap.NewNop(), SweepOptions{MaxAge: 7 * 24 * time.Hour, Keep: pending.Has})
+	if err != nil {
+		t.Fatalf("Error sweeping: %s", err)
+	}
+	if len(result.ClosedPullRequests) != 0 || len(result.DeletedBranches) != 0 || fake.counts["closePullRequest"] != 0 {
+		t.Errorf("Expected the pending PR and its branch to be kept, got %v", result)
+	}
+}
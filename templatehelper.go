@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var (
+	defaultBranchNameTemplate    = `dora-the-explorer-{{.Epoch}}`
+	defaultCommitMessageTemplate = `Updated version in terragrunt.hcl`
+	defaultPRTitleTemplate       = `{{.CommitType}}: Change app version`
+	defaultPRBodyTemplate        = `Generated by Dora the Explorer`
+	defaultCommitTypes           = `fix`
+	defaultWorkItemPrefix        = "DORA"
+)
+
+// The data available to the branch name, commit message, pull request title
+// and pull request body templates.
+type ChangeTemplateData struct {
+	TeamLevel       string
+	ChangeType      string // "upgrade" or "downgrade"
+	FromVersion     string
+	ToVersion       string
+	WorkItem        string
+	IntendedOutcome string // "success" or "failure"
+	CommitType      string // Conventional commit prefix, ie: feat, fix, chore
+	Author          string // Name of the persona making the change
+	Epoch           int64  // Milliseconds since the unix epoch
+}
+
+// The rendered values for a single generated change
+type RenderedChange struct {
+	BranchName    string
+	CommitMessage string
+	PRTitle       string
+	PRBody        string
+	HeadSHA       string // The commit pushed to BranchName, set once it is pushed
+}
+
+type ChangeTemplates struct {
+	BranchName     *template.Template
+	CommitMessage  *template.Template
+	PRTitle        *template.Template
+	PRBody         *template.Template
+	CommitTypes    []Weighted[string]
+	WorkItemPrefix string
+
+	// The fixed text the branch name template starts with. Branches with this
+	// prefix, and pull requests from them, are treated as generated.
+	BranchPrefix string
+}
+
+// Builds the change templates from the DORA_*_TEMPLATE environment variables,
+// falling back to the defaults when they are not set.
+func NewChangeTemplatesFromEnv() (*ChangeTemplates, error) {
+	ct := &ChangeTemplates{}
+
+	var err error
+	templates := []struct {
+		envVar       string
+		defaultValue string
+		target       **template.Template
+	}{
+		{"DORA_BRANCH_TEMPLATE", defaultBranchNameTemplate, &ct.BranchName},
+		{"DORA_COMMIT_MESSAGE_TEMPLATE", defaultCommitMessageTemplate, &ct.CommitMessage},
+		{"DORA_PR_TITLE_TEMPLATE", defaultPRTitleTemplate, &ct.PRTitle},
+		{"DORA_PR_BODY_TEMPLATE", defaultPRBodyTemplate, &ct.PRBody},
+	}
+	for _, t := range templates {
+		text := os.Getenv(t.envVar)
+		if text == "" {
+			text = t.defaultValue
+		}
+		*t.target, err = template.New(t.envVar).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %s", t.envVar, err)
+		}
+		if t.target == &ct.BranchName {
+			ct.BranchPrefix, _, _ = strings.Cut(text, "{{")
+		}
+	}
+	if ct.BranchPrefix == "" {
+		return nil, errors.New("DORA_BRANCH_TEMPLATE must start with fixed text, it is used to recognise generated branches")
+	}
+
+	commitTypes := os.Getenv("DORA_COMMIT_TYPES")
+	if commitTypes == "" {
+		commitTypes = defaultCommitTypes
+	}
+	ct.CommitTypes, err = ParseWeightedList(commitTypes)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing DORA_COMMIT_TYPES: %s", err)
+	}
+
+	ct.WorkItemPrefix = os.Getenv("DORA_WORK_ITEM_PREFIX")
+	if ct.WorkItemPrefix == "" {
+		ct.WorkItemPrefix = defaultWorkItemPrefix
+	}
+
+	return ct, nil
+}
+
+// Creates the template data for a new change. The version fields are filled in
+// once the target file has been read.
+func (ct *ChangeTemplates) NewChangeData(doraTeam *DoraTeam) *ChangeTemplateData {
+	return &ChangeTemplateData{
+		TeamLevel: doraTeam.Level,
+		//nolint:gosec // No security issue, just need a psudo-random work item number
+		WorkItem:        fmt.Sprintf("%s-%d", ct.WorkItemPrefix, rand.Intn(9000)+1000),
+		IntendedOutcome: doraTeam.SampleIntendedOutcome(),
+		CommitType:      PickWeighted(ct.CommitTypes),
+		Epoch:           time.Now().UnixMilli(),
+	}
+}
+
+func (ct *ChangeTemplates) Render(data *ChangeTemplateData) (*RenderedChange, error) {
+	rc := &RenderedChange{}
+
+	outputs := []struct {
+		tmpl   *template.Template
+		target *string
+	}{
+		{ct.BranchName, &rc.BranchName},
+		{ct.CommitMessage, &rc.CommitMessage},
+		{ct.PRTitle, &rc.PRTitle},
+		{ct.PRBody, &rc.PRBody},
+	}
+	for _, o := range outputs {
+		var buf bytes.Buffer
+		if err := o.tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("Error rendering %s: %s", o.tmpl.Name(), err)
+		}
+		*o.target = buf.String()
+	}
+
+	rc.BranchName = strings.TrimSpace(rc.BranchName)
+	if rc.BranchName == "" {
+		return nil, fmt.Errorf("Rendered branch name is empty")
+	}
+
+	return rc, nil
+}
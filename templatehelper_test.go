@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestRenderDefaultTemplates(t *testing.T) {
+	ct, err := NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading templates: %s", err)
+	}
+
+	rc, err := ct.Render(&ChangeTemplateData{CommitType: "fix", Epoch: 1700000000000})
+	if err != nil {
+		t.Fatalf("Error rendering templates: %s", err)
+	}
+
+	if rc.BranchName != "dora-the-explorer-1700000000000" {
+		t.Errorf("Expected branch name to be dora-the-explorer-1700000000000, got %s", rc.BranchName)
+	}
+	if rc.CommitMessage != "Updated version in terragrunt.hcl" {
+		t.Errorf("Expected default commit message, got %s", rc.CommitMessage)
+	}
+	if rc.PRTitle != "fix: Change app version" {
+		t.Errorf("Expected PR title to be fix: Change app version, got %s", rc.PRTitle)
+	}
+	if rc.PRBody != "Generated by Dora the Explorer" {
+		t.Errorf("Expected default PR body, got %s", rc.PRBody)
+	}
+}
+
+func TestRenderCustomTemplates(t *testing.T) {
+	os.Setenv("DORA_PR_TITLE_TEMPLATE", "{{.CommitType}}({{.WorkItem}}): {{.ChangeType}} {{.FromVersion}} -> {{.ToVersion}}")
+	os.Setenv("DORA_COMMIT_TYPES", "feat:1,fix:0")
+	defer func() {
+		os.Unsetenv("DORA_PR_TITLE_TEMPLATE")
+		os.Unsetenv("DORA_COMMIT_TYPES")
+	}()
+
+	ct, err := NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading templates: %s", err)
+	}
+
+	data := ct.NewChangeData(NewEliteDoraTeam())
+	data.WorkItem = "DORA-1234"
+	data.ChangeType = "upgrade"
+	data.FromVersion = "v0.3.0"
+	data.ToVersion = "v0.6.2"
+
+	rc, err := ct.Render(data)
+	if err != nil {
+		t.Fatalf("Error rendering templates: %s", err)
+	}
+	if rc.PRTitle != "feat(DORA-1234): upgrade v0.3.0 -> v0.6.2" {
+		t.Errorf("Unexpected PR title: %s", rc.PRTitle)
+	}
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	os.Setenv("DORA_BRANCH_TEMPLATE", "{{.Epoch")
+	defer os.Unsetenv("DORA_BRANCH_TEMPLATE")
+
+	if _, err := NewChangeTemplatesFromEnv(); err == nil {
+		t.Errorf("Expected an error parsing an invalid template")
+	}
+}
+
+func TestBranchPrefix(t *testing.T) {
+	ct, err := NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading templates: %s", err)
+	}
+	if ct.BranchPrefix != "dora-the-explorer-" {
+		t.Errorf("Unexpected default branch prefix: %s", ct.BranchPrefix)
+	}
+
+	os.Setenv("DORA_BRANCH_TEMPLATE", "sim/{{.WorkItem}}-{{.Epoch}}")
+	defer os.Unsetenv("DORA_BRANCH_TEMPLATE")
+	ct, err = NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatalf("Error loading templates: %s", err)
+	}
+	if ct.BranchPrefix != "sim/" {
+		t.Errorf("Expected branch prefix sim/, got %s", ct.BranchPrefix)
+	}
+
+	os.Setenv("DORA_BRANCH_TEMPLATE", "{{.WorkItem}}-{{.Epoch}}")
+	if _, err := NewChangeTemplatesFromEnv(); err == nil {
+		t.Errorf("Expected an error for a branch template without a fixed prefix")
+	}
+}
+
+func TestParseWeightedList(t *testing.T) {
+	choices, err := ParseWeightedList("feat:3, fix:4,chore")
+	if err != nil {
+		t.Fatalf("Error parsing weighted list: %s", err)
+	}
+	if len(choices) != 3 {
+		t.Fatalf("Expected 3 choices, got %d", len(choices))
+	}
+	if choices[1].Value != "fix" || choices[1].Weight != 4 {
+		t.Errorf("Expected fix:4, got %s:%d", choices[1].Value, choices[1].Weight)
+	}
+	if choices[2].Weight != 1 {
+		t.Errorf("Expected default weight of 1, got %d", choices[2].Weight)
+	}
+
+	if _, err := ParseWeightedList("feat:x"); err == nil {
+		t.Errorf("Expected an error for an invalid weight")
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+)
+
+// Records the spans started through the package tracer for the rest of the test
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := tracer
+	tracer = provider.Tracer(tracerName)
+	t.Cleanup(func() { tracer = previous })
+	return recorder
+}
+
+func TestGeneratePullRequestSpans(t *testing.T) {
+	recorder := recordSpans(t)
+	_, ghrc := newFakeGitHub(t, map[string]string{
+		"getDefaultBranch":     `{"repository":{"id":"R_1","defaultBranchRef":{"name":"main","target":{"__typename":"Commit","oid":"abc123"}}}}`,
+		"getRepositoryFile":    `{"repository":{"object":{"__typename":"Blob","text":"source = \"github.com/liatrio/dora-lambda-tf-module-demo?ref=v0.6.2\"","isBinary":false}}}`,
+		"createRef":            `{"createRef":{"ref":{"id":"REF_1","name":"refs/heads/dora-the-explorer-1"}}}`,
+		"createCommitOnBranch": `{"createCommitOnBranch":{"commit":{"oid":"def456","url":"https://example.com"}}}`,
+		"createPullRequest":    `{"createPullRequest":{"pullRequest":{"id":"PR_1","number":7}}}`,
+	})
+	ghrc.changeMode = ChangeModeAPI
+	ghrc.history = NewHistory(10, zap.NewNop())
+	var err error
+	ghrc.templates, err = NewChangeTemplatesFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, parent := tracer.Start(context.Background(), "change cycle")
+	data := &ChangeTemplateData{CommitType: "fix", Epoch: 1}
+	if _, err := ghrc.GeneratePullRequest(ctx, zap.NewNop(), data, &defaultPersona); err != nil {
+		t.Fatalf("Error generating pull request: %s", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	names := []string{"generate change", "create pull request", "change cycle"}
+	if len(spans) != len(names) {
+		t.Fatalf("Expected spans %v, got %d spans", names, len(spans))
+	}
+	for i, span := range spans {
+		if span.Name() != names[i] {
+			t.Errorf("Expected span %d to be %s, got %s", i, names[i], span.Name())
+		}
+		if i < 2 && span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Expected %s to be a child of the change cycle", span.Name())
+		}
+	}
+
+	var number int64
+	for _, attr := range spans[1].Attributes() {
+		if attr.Key == attrPRNumber {
+			number = attr.Value.AsInt64()
+		}
+	}
+	if number != 7 {
+		t.Errorf("Expected the pull request span to carry PR 7, got %d", number)
+	}
+}
+
+func TestEndSpanRecordsError(t *testing.T) {
+	recorder := recordSpans(t)
+
+	_, span := tracer.Start(context.Background(), "merge")
+	endSpan(span, errors.New("merge conflict"))
+	_, span = tracer.Start(context.Background(), "deploy")
+	endSpan(span, nil)
+
+	spans := recorder.Ended()
+	if spans[0].Status().Code != codes.Error || len(spans[0].Events()) != 1 {
+		t.Errorf("Expected the failed span to record the error, got %v", spans[0].Status())
+	}
+	if spans[1].Status().Code != codes.Unset {
+		t.Errorf("Expected the successful span to keep an unset status, got %v", spans[1].Status())
+	}
+}
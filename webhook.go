@@ -0,0 +1,194 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// The largest webhook payload accepted, GitHub caps payloads at 25MB
+const maxWebhookPayload = 25 << 20
+
+// Receives GitHub webhooks and wakes the waiters interested in them, so a
+// change is noticed as soon as GitHub reports it instead of on the next poll.
+// Waiters subscribe to a commit SHA or a pull request number.
+type WebhookReceiver struct {
+	secret  []byte
+	logger  *zap.Logger
+	mu      sync.Mutex
+	waiters map[string][]chan struct{}
+}
+
+func NewWebhookReceiver(secret string, logger *zap.Logger) *WebhookReceiver {
+	return &WebhookReceiver{secret: []byte(secret), logger: logger, waiters: map[string][]chan struct{}{}}
+}
+
+func shaKey(sha string) string {
+	return "sha:" + sha
+}
+
+func pullRequestKey(number int) string {
+	return fmt.Sprintf("pr:%d", number)
+}
+
+// Returns a channel that is signalled whenever an event for one of the keys
+// arrives, and a function to unsubscribe. A nil receiver returns a nil
+// channel, which never fires, so callers fall back to polling.
+func (wr *WebhookReceiver) Subscribe(keys ...string) (<-chan struct{}, func()) {
+	if wr == nil {
+		return nil, func() {}
+	}
+
+	wake := make(chan struct{}, 1)
+	wr.mu.Lock()
+	for _, key := range keys {
+		wr.waiters[key] = append(wr.waiters[key], wake)
+	}
+	wr.mu.Unlock()
+
+	return wake, func() {
+		wr.mu.Lock()
+		defer wr.mu.Unlock()
+		for _, key := range keys {
+			waiters := wr.waiters[key]
+			for i, w := range waiters {
+				if w == wake {
+					waiters = append(waiters[:i], waiters[i+1:]...)
+					break
+				}
+			}
+			if len(waiters) == 0 {
+				delete(wr.waiters, key)
+			} else {
+				wr.waiters[key] = waiters
+			}
+		}
+	}
+}
+
+// Signals every waiter subscribed to one of the keys without blocking
+func (wr *WebhookReceiver) wake(keys ...string) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	for _, key := range keys {
+		for _, w := range wr.waiters[key] {
+			select {
+			case w <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// The fields of the supported events that identify what they are about
+type webhookPayload struct {
+	Sha      string `json:"sha"`
+	CheckRun *struct {
+		HeadSha      string               `json:"head_sha"`
+		PullRequests []webhookPullRequest `json:"pull_requests"`
+	} `json:"check_run"`
+	CheckSuite *struct {
+		HeadSha      string               `json:"head_sha"`
+		PullRequests []webhookPullRequest `json:"pull_requests"`
+	} `json:"check_suite"`
+	Deployment *struct {
+		Sha string `json:"sha"`
+	} `json:"deployment"`
+	PullRequest *struct {
+		Number int `json:"number"`
+		Head   struct {
+			Sha string `json:"sha"`
+		} `json:"head"`
+	} `json:"pull_request"`
+}
+
+type webhookPullRequest struct {
+	Number int `json:"number"`
+}
+
+// Returns the keys of the waiters interested in the event
+func webhookKeys(event string, payload *webhookPayload) []string {
+	var keys []string
+	addPullRequests := func(prs []webhookPullRequest) {
+		for _, pr := range prs {
+			keys = append(keys, pullRequestKey(pr.Number))
+		}
+	}
+
+	switch event {
+	case "check_run":
+		if payload.CheckRun != nil {
+			keys = append(keys, shaKey(payload.CheckRun.HeadSha))
+			addPullRequests(payload.CheckRun.PullRequests)
+		}
+	case "check_suite":
+		if payload.CheckSuite != nil {
+			keys = append(keys, shaKey(payload.CheckSuite.HeadSha))
+			addPullRequests(payload.CheckSuite.PullRequests)
+		}
+	case "status":
+		keys = append(keys, shaKey(payload.Sha))
+	case "deployment_status":
+		if payload.Deployment != nil {
+			keys = append(keys, shaKey(payload.Deployment.Sha))
+		}
+	case "pull_request":
+		if payload.PullRequest != nil {
+			keys = append(keys, pullRequestKey(payload.PullRequest.Number), shaKey(payload.PullRequest.Head.Sha))
+		}
+	}
+	return keys
+}
+
+// Verifies the X-Hub-Signature-256 header against the payload
+func (wr *WebhookReceiver) verify(signature string, body []byte) bool {
+	digest, found := strings.CutPrefix(signature, "sha256=")
+	if !found {
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, wr.secret)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (wr *WebhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayload))
+	if err != nil {
+		http.Error(w, "error reading payload", http.StatusBadRequest)
+		return
+	}
+	if !wr.verify(r.Header.Get("X-Hub-Signature-256"), body) {
+		wr.logger.Sugar().Warnf("Rejected webhook with an invalid signature from %s", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	event := r.Header.Get("X-GitHub-Event")
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	keys := webhookKeys(event, &payload)
+	wr.logger.Sugar().Debugf("Received %s webhook for %v", event, keys)
+	wr.wake(keys...)
+	w.WriteHeader(http.StatusNoContent)
+}
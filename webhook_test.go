@@ -0,0 +1,84 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func signedWebhook(secret string, event string, body string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func woken(wake <-chan struct{}) bool {
+	select {
+	case <-wake:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestWebhookReceiver(t *testing.T) {
+	wr := NewWebhookReceiver("s3cret", zap.NewNop())
+	prWake, unsubscribePR := wr.Subscribe(pullRequestKey(7))
+	defer unsubscribePR()
+	shaWake, unsubscribeSha := wr.Subscribe(shaKey("abc123"))
+	defer unsubscribeSha()
+
+	events := []struct {
+		event   string
+		body    string
+		prWoke  bool
+		shaWoke bool
+	}{
+		{"check_run", `{"check_run":{"head_sha":"abc123","pull_requests":[{"number":7}]}}`, true, true},
+		{"check_suite", `{"check_suite":{"head_sha":"def456","pull_requests":[{"number":7}]}}`, true, false},
+		{"status", `{"sha":"abc123"}`, false, true},
+		{"deployment_status", `{"deployment":{"sha":"abc123"}}`, false, true},
+		{"pull_request", `{"pull_request":{"number":7,"head":{"sha":"def456"}}}`, true, false},
+		{"push", `{"after":"abc123"}`, false, false},
+	}
+	for _, e := range events {
+		rec := httptest.NewRecorder()
+		wr.ServeHTTP(rec, signedWebhook("s3cret", e.event, e.body))
+		if rec.Code != http.StatusNoContent {
+			t.Errorf("Expected %s to be accepted, got %d", e.event, rec.Code)
+		}
+		if woken(prWake) != e.prWoke || woken(shaWake) != e.shaWoke {
+			t.Errorf("Expected %s to wake the PR waiter %v and the SHA waiter %v", e.event, e.prWoke, e.shaWoke)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	wr.ServeHTTP(rec, signedWebhook("wrong", "status", `{"sha":"abc123"}`))
+	if rec.Code != http.StatusUnauthorized || woken(shaWake) {
+		t.Errorf("Expected a webhook with an invalid signature to be rejected, got %d", rec.Code)
+	}
+
+	unsubscribeSha()
+	wr.ServeHTTP(httptest.NewRecorder(), signedWebhook("s3cret", "status", `{"sha":"abc123"}`))
+	if woken(shaWake) {
+		t.Errorf("Expected an unsubscribed waiter not to be woken")
+	}
+}
+
+func TestNilWebhookReceiver(t *testing.T) {
+	var wr *WebhookReceiver
+	wake, unsubscribe := wr.Subscribe(shaKey("abc123"))
+	defer unsubscribe()
+	if wake != nil {
+		t.Errorf("Expected no wake channel without webhooks")
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+type Weighted[T any] struct {
+	Value  T
+	Weight int
+}
+
+// Picks a value from the list with a probability proportional to its weight.
+// Entries with a weight of 0 or less are never picked. Returns the zero value
+// of T if the list has no positive weights.
+func PickWeighted[T any](choices []Weighted[T]) T {
+	var zero T
+	total := 0
+	for _, c := range choices {
+		if c.Weight > 0 {
+			total += c.Weight
+		}
+	}
+	if total == 0 {
+		return zero
+	}
+
+	//nolint:gosec // No security issue, just need a psudo-random choice
+	n := rand.Intn(total)
+	for _, c := range choices {
+		if c.Weight <= 0 {
+			continue
+		}
+		if n < c.Weight {
+			return c.Value
+		}
+		n -= c.Weight
+	}
+	return zero
+}
+
+// Parses a comma separated list of `value:weight` pairs, for example
+// "feat:3,fix:4,chore:2". A value without a weight is given a weight of 1.
+func ParseWeightedList(s string) ([]Weighted[string], error) {
+	var choices []Weighted[string]
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		value, weightString, found := strings.Cut(item, ":")
+		weight := 1
+		if found {
+			var err error
+			weight, err = strconv.Atoi(strings.TrimSpace(weightString))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid weight for %s: %s", value, err)
+			}
+			if weight < 0 {
+				return nil, fmt.Errorf("Invalid weight for %s: must not be negative", value)
+			}
+		}
+		choices = append(choices, Weighted[string]{Value: strings.TrimSpace(value), Weight: weight})
+	}
+
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("No values found in %q", s)
+	}
+	return choices, nil
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/idxfile"
+	"github.com/go-git/go-git/v5/plumbing/format/objfile"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/filesystem/dotgit"
+	"go.uber.org/zap"
+)
+
+// Returned by refreshWorkingCopy when there is nothing to refresh, or when the
+// working copy is a clone of the target repository that is broken. Either way
+// it is safe to clone into the directory again.
+var (
+	errNoWorkingCopy      = errors.New("No working copy")
+	errCorruptWorkingCopy = errors.New("Working copy is corrupt")
+)
+
+// A checkout of the target repository that a change can be made in
+type WorkingCopy struct {
+	Dir         string
+	Repo        *git.Repository
+	BaseRefName string // The remote's default branch
+
+	cleanup func()
+}
+
+// Removes the working copy if it is temporary. Persistent working copies are
+// kept for the next change.
+func (wc *WorkingCopy) Close() {
+	if wc.cleanup != nil {
+		wc.cleanup()
+	}
+}
+
+func (ghrc *GitHubRepoContext) gitAuth(username string, token string) *githttp.BasicAuth {
+	return &githttp.BasicAuth{
+		Username: username,
+		Password: token,
+	}
+}
+
+// Returns a working copy checked out at the remote's default branch.
+//
+// Without a persistent directory the repository is cloned into a temp directory
+// that is removed on Close. With one, the existing clone is fetched and hard
+// reset to the remote default branch. The directory is only cloned into when it
+// is empty, or removed and cloned again when it holds a corrupt clone of the
+// target repository. Anything else, including a directory that is not a clone
+// of the target and failures to reach the remote, is returned as an error
+// without touching the directory.
+func (ghrc *GitHubRepoContext) PrepareWorkingCopy(ctx context.Context, logger *zap.Logger) (*WorkingCopy, error) {
+	if ghrc.localDir == "" {
+		dir, err := os.MkdirTemp("", "cloned-repo")
+		if err != nil {
+			logger.Sugar().Errorf("Error creating temp dir: %s", err)
+			return nil, err
+		}
+		logger.Sugar().Infof("Temp dir is: %v", dir)
+
+		wc, err := ghrc.cloneWorkingCopy(ctx, dir)
+		if err != nil {
+			os.RemoveAll(dir)
+			logger.Sugar().Errorf("Error cloning repository: %s", err)
+			return nil, err
+		}
+		wc.cleanup = func() { os.RemoveAll(dir) }
+		return wc, nil
+	}
+
+	wc, err := ghrc.refreshWorkingCopy(ctx, ghrc.localDir)
+	switch {
+	case err == nil:
+		return wc, nil
+	case errors.Is(err, errNoWorkingCopy):
+		logger.Sugar().Infof("No working copy in %s, cloning", ghrc.localDir)
+	case errors.Is(err, errCorruptWorkingCopy):
+		logger.Sugar().Warnf("Working copy in %s is unusable, re-cloning: %s", ghrc.localDir, err)
+	default:
+		logger.Sugar().Errorf("Error refreshing working copy: %s", err)
+		return nil, err
+	}
+
+	if err := os.RemoveAll(ghrc.localDir); err != nil {
+		logger.Sugar().Errorf("Error removing working copy: %s", err)
+		return nil, err
+	}
+	if err := os.MkdirAll(ghrc.localDir, 0700); err != nil {
+		logger.Sugar().Errorf("Error creating working copy dir: %s", err)
+		return nil, err
+	}
+
+	wc, err = ghrc.cloneWorkingCopy(ctx, ghrc.localDir)
+	if err != nil {
+		logger.Sugar().Errorf("Error cloning repository: %s", err)
+		return nil, err
+	}
+	return wc, nil
+}
+
+func (ghrc *GitHubRepoContext) cloneWorkingCopy(ctx context.Context, dir string) (*WorkingCopy, error) {
+	opts := &git.CloneOptions{
+		URL:  ghrc.remoteRepoUrl,
+		Auth: ghrc.gitAuth("dora-the-explorer", ghrc.pat),
+	}
+	if ghrc.shallowClone {
+		opts.Depth = 1
+		opts.SingleBranch = true
+	}
+
+	// Clones the repository into the given dir, just as a normal git clone does
+	repo, err := git.PlainCloneContext(ctx, dir, false, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting HEAD: %s", err)
+	}
+
+	return &WorkingCopy{
+		Dir:         dir,
+		Repo:        repo,
+		BaseRefName: head.Name().Short(),
+	}, nil
+}
+
+// Fetches the working copy in dir and resets it to the remote default branch.
+// Returns errNoWorkingCopy when dir is missing or empty and an error wrapping
+// errCorruptWorkingCopy when it is a clone of the target repository that is
+// broken. Other errors leave dir as it was.
+func (ghrc *GitHubRepoContext) refreshWorkingCopy(ctx context.Context, dir string) (*WorkingCopy, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && len(entries) == 0) {
+		return nil, errNoWorkingCopy
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s: %s", dir, err)
+	}
+
+	repo, err := git.PlainOpen(dir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		return nil, fmt.Errorf("%s is not empty and not a git repository, refusing to replace it", dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error opening working copy in %s: %s", dir, err)
+	}
+
+	remote, err := repo.Remote("origin")
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the origin of %s: %s", dir, err)
+	}
+	if urls := remote.Config().URLs; len(urls) == 0 || urls[0] != ghrc.remoteRepoUrl {
+		return nil, fmt.Errorf("%s is a clone of %v, not %s, refusing to replace it", dir, urls, ghrc.remoteRepoUrl)
+	}
+
+	// Check the clone before contacting the remote, so a broken clone is told
+	// apart from a remote that cannot be reached
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("%w: Error getting HEAD: %s", errCorruptWorkingCopy, err)
+	}
+	if _, err := repo.CommitObject(head.Hash()); err != nil {
+		return nil, fmt.Errorf("%w: Error reading HEAD commit: %s", errCorruptWorkingCopy, err)
+	}
+
+	auth := ghrc.gitAuth("dora-the-explorer", ghrc.pat)
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing remote refs: %s", err)
+	}
+	baseRefName, err := remoteDefaultBranch(refs)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteRef := plumbing.NewRemoteReferenceName("origin", baseRefName)
+	fetchOpts := &git.FetchOptions{
+		RemoteName: "origin",
+		Auth:       auth,
+		Force:      true,
+		RefSpecs: []config.RefSpec{
+			config.RefSpec("+" + plumbing.NewBranchReferenceName(baseRefName).String() + ":" + remoteRef.String()),
+		},
+	}
+	if ghrc.shallowClone {
+		fetchOpts.Depth = 1
+	}
+	err = repo.FetchContext(ctx, fetchOpts)
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		if isObjectStorageError(err) {
+			return nil, fmt.Errorf("%w: Error fetching: %s", errCorruptWorkingCopy, err)
+		}
+		return nil, fmt.Errorf("Error fetching: %s", err)
+	}
+
+	// The remote has been reached, failures from here on are local
+	target, err := repo.Reference(remoteRef, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Error resolving %s: %s", errCorruptWorkingCopy, remoteRef, err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errCorruptWorkingCopy, err)
+	}
+
+	// Point the local default branch at the remote one and check it out
+	localRef := plumbing.NewBranchReferenceName(baseRefName)
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(localRef, target.Hash())); err != nil {
+		return nil, fmt.Errorf("%w: %s", errCorruptWorkingCopy, err)
+	}
+	if err := worktree.Checkout(&git.CheckoutOptions{Branch: localRef, Force: true}); err != nil {
+		return nil, fmt.Errorf("%w: Error checking out %s: %s", errCorruptWorkingCopy, baseRefName, err)
+	}
+	if err := worktree.Reset(&git.ResetOptions{Commit: target.Hash(), Mode: git.HardReset}); err != nil {
+		return nil, fmt.Errorf("%w: Error resetting to %s: %s", errCorruptWorkingCopy, remoteRef, err)
+	}
+	if err := worktree.Clean(&git.CleanOptions{Dir: true}); err != nil {
+		return nil, fmt.Errorf("%w: Error cleaning worktree: %s", errCorruptWorkingCopy, err)
+	}
+
+	// Remove branches left over from previous changes
+	branches, err := repo.Branches()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errCorruptWorkingCopy, err)
+	}
+	err = branches.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Name() == localRef {
+			return nil
+		}
+		return repo.Storer.RemoveReference(ref.Name())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: Error removing old branches: %s", errCorruptWorkingCopy, err)
+	}
+
+	return &WorkingCopy{
+		Dir:         dir,
+		Repo:        repo,
+		BaseRefName: baseRefName,
+	}, nil
+}
+
+// Whether a go-git error comes from reading or writing the objects of the
+// local repository, such as a missing or broken pack, rather than from
+// talking to the remote
+func isObjectStorageError(err error) bool {
+	var packErr *packfile.Error
+	return errors.As(err, &packErr) ||
+		errors.Is(err, plumbing.ErrObjectNotFound) ||
+		errors.Is(err, plumbing.ErrInvalidType) ||
+		errors.Is(err, packfile.ErrReferenceDeltaNotFound) ||
+		errors.Is(err, packfile.ErrInvalidDelta) ||
+		errors.Is(err, idxfile.ErrMalformedIdxFile) ||
+		errors.Is(err, objfile.ErrHeader) ||
+		errors.Is(err, dotgit.ErrPackfileNotFound) ||
+		errors.Is(err, dotgit.ErrIdxNotFound) ||
+		errors.Is(err, fs.ErrNotExist)
+}
+
+// Finds the default branch from the refs advertised by the remote
+func remoteDefaultBranch(refs []*plumbing.Reference) (string, error) {
+	var head *plumbing.Reference
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD {
+			head = ref
+			break
+		}
+	}
+	if head == nil {
+		return "", errors.New("Remote did not advertise HEAD")
+	}
+
+	if head.Type() == plumbing.SymbolicReference {
+		return head.Target().Short(), nil
+	}
+
+	// Older servers don't advertise the symref, match HEAD to a branch instead
+	for _, ref := range refs {
+		if ref.Name().IsBranch() && ref.Hash() == head.Hash() {
+			return ref.Name().Short(), nil
+		}
+	}
+	return "", errors.New("Could not determine the remote default branch")
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/filesystem/dotgit"
+	"go.uber.org/zap"
+)
+
+// Creates a repository with a single commit on main to act as the remote
+func newTestRemote(t *testing.T) string {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("Error creating remote: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "envs/dev"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	hcl := `source = "github.com/liatrio/dora-lambda-tf-module-demo?ref=v0.3.0"`
+	if err := os.WriteFile(filepath.Join(dir, hclPath), []byte(hcl), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := worktree.Add(hclPath); err != nil {
+		t.Fatal(err)
+	}
+	_, err = worktree.Commit("initial", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestPersistentWorkingCopy(t *testing.T) {
+	ghrc := &GitHubRepoContext{
+		remoteRepoUrl: newTestRemote(t),
+		localDir:      filepath.Join(t.TempDir(), "workdir"),
+	}
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	wc, err := ghrc.PrepareWorkingCopy(ctx, logger)
+	if err != nil {
+		t.Fatalf("Error preparing working copy: %s", err)
+	}
+	wc.Close()
+	if _, err := os.Stat(filepath.Join(ghrc.localDir, hclPath)); err != nil {
+		t.Fatalf("Expected persistent working copy to survive Close: %s", err)
+	}
+
+	// Dirty the working copy, it should be reset on the next prepare
+	if err := os.WriteFile(filepath.Join(ghrc.localDir, hclPath), []byte("dirty"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	wc, err = ghrc.PrepareWorkingCopy(ctx, logger)
+	if err != nil {
+		t.Fatalf("Error refreshing working copy: %s", err)
+	}
+	bb, err := os.ReadFile(filepath.Join(wc.Dir, hclPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CurrentVersion(bb) != "v0.3.0" {
+		t.Errorf("Expected working copy to be reset, got %s", bb)
+	}
+	if wc.BaseRefName != "master" {
+		t.Errorf("Expected base ref to be master, got %s", wc.BaseRefName)
+	}
+
+	// Corrupt the working copy, it should be re-cloned
+	if err := os.RemoveAll(filepath.Join(ghrc.localDir, ".git", "objects")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ghrc.PrepareWorkingCopy(ctx, logger); err != nil {
+		t.Fatalf("Expected corrupted working copy to be re-cloned: %s", err)
+	}
+}
+
+func TestPersistentWorkingCopyRefusesOtherDirectories(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	// A directory with other data in it is never replaced
+	dir := t.TempDir()
+	precious := filepath.Join(dir, "precious.txt")
+	if err := os.WriteFile(precious, []byte("keep me"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ghrc := &GitHubRepoContext{remoteRepoUrl: newTestRemote(t), localDir: dir}
+	if _, err := ghrc.PrepareWorkingCopy(ctx, logger); err == nil {
+		t.Errorf("Expected a non-empty directory that is not a repository to be refused")
+	}
+	if _, err := os.Stat(precious); err != nil {
+		t.Errorf("Expected the directory to be left alone: %s", err)
+	}
+
+	// Neither is a clone of another repository
+	other := &GitHubRepoContext{remoteRepoUrl: newTestRemote(t), localDir: filepath.Join(t.TempDir(), "workdir")}
+	if _, err := other.PrepareWorkingCopy(ctx, logger); err != nil {
+		t.Fatal(err)
+	}
+	ghrc.localDir = other.localDir
+	if _, err := ghrc.PrepareWorkingCopy(ctx, logger); err == nil {
+		t.Errorf("Expected a clone of another repository to be refused")
+	}
+	if _, err := os.Stat(filepath.Join(other.localDir, ".git")); err != nil {
+		t.Errorf("Expected the other clone to be left alone: %s", err)
+	}
+}
+
+func TestPersistentWorkingCopyKeptWhenRemoteUnreachable(t *testing.T) {
+	remote := newTestRemote(t)
+	ghrc := &GitHubRepoContext{remoteRepoUrl: remote, localDir: filepath.Join(t.TempDir(), "workdir")}
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	if _, err := ghrc.PrepareWorkingCopy(ctx, logger); err != nil {
+		t.Fatal(err)
+	}
+
+	// Take the remote away, as a network outage would
+	if err := os.Rename(remote, remote+"-gone"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ghrc.PrepareWorkingCopy(ctx, logger); err == nil {
+		t.Errorf("Expected an unreachable remote to be an error")
+	}
+	if _, err := os.Stat(filepath.Join(ghrc.localDir, hclPath)); err != nil {
+		t.Errorf("Expected the working copy to be kept: %s", err)
+	}
+}
+
+func TestIsObjectStorageError(t *testing.T) {
+	tests := []struct {
+		err   error
+		local bool
+	}{
+		{fmt.Errorf("Error reading tree: %w", plumbing.ErrObjectNotFound), true},
+		{packfile.ErrZLib.AddDetails("unexpected EOF"), true},
+		{dotgit.ErrPackfileNotFound, true},
+		{&fs.PathError{Op: "open", Path: ".git/objects/pack/pack-1.pack", Err: fs.ErrNotExist}, true},
+		{transport.ErrAuthenticationRequired, false},
+		{transport.ErrRepositoryNotFound, false},
+		{context.DeadlineExceeded, false},
+	}
+	for _, tt := range tests {
+		if local := isObjectStorageError(tt.err); local != tt.local {
+			t.Errorf("Expected isObjectStorageError(%v) to be %t", tt.err, tt.local)
+		}
+	}
+}